@@ -0,0 +1,84 @@
+package main
+
+import (
+	"image/color"
+
+	"github.com/faiface/pixel"
+	"github.com/mmogo/mmo/client/game"
+	"github.com/mmogo/mmo/client/ui"
+	"github.com/mmogo/mmo/shared"
+	"golang.org/x/image/colornames"
+)
+
+const (
+	chatLogLines = 8   // lines of the chat log shown at once
+	chatMaxLen   = 200 // characters in a line typed into chat
+)
+
+var chatColors = map[shared.ChatChannel]color.Color{
+	shared.CHAT_SAY:     colornames.White,
+	shared.CHAT_SHOUT:   colornames.Orange,
+	shared.CHAT_WHISPER: colornames.Violet,
+	shared.CHAT_PARTY:   colornames.Lightskyblue,
+	shared.CHAT_SYSTEM:  colornames.Yellow,
+}
+
+// addChat adds the chat log, scrolled with the mouse wheel, and the line chat is typed into,
+// hidden until chat is opened
+func (f *frontend) addChat() {
+	f.chatLog = &ui.List{
+		Color:  pixel.RGB(0, 0, 0).Mul(pixel.Alpha(0.5)),
+		Follow: true,
+	}
+	f.chatInput = &ui.TextInput{
+		Box:         ui.Box{Hidden: true},
+		Max:         chatMaxLen,
+		Placeholder: "say something, or /w name, /party",
+		OnSubmit:    f.sendChat,
+		OnCancel:    f.closeChat,
+	}
+	f.ui.Add(f.chatLog, f.chatInput)
+}
+
+// openChat shows the chat line and gives it the keyboard
+func (f *frontend) openChat() {
+	f.chatInput.Hidden = false
+	f.ui.Focus(f.chatInput)
+}
+
+// sendChat says the line typed and closes chat
+func (f *frontend) sendChat(text string) error {
+	f.closeChat()
+	return f.world.Speak(text, f.conn)
+}
+
+// closeChat drops the line typed and hides it
+func (f *frontend) closeChat() error {
+	f.chatInput.SetText("")
+	f.chatInput.Hidden = true
+	f.ui.Blur()
+	return nil
+}
+
+// processChatScroll scrolls the chat log a page with page up and down
+func (f *frontend) processChatScroll() {
+	if f.justPressed(actChatScrollUp) {
+		f.chatLog.ScrollBy(-f.chatLog.Page())
+	}
+	if f.justPressed(actChatScrollDn) {
+		f.chatLog.ScrollBy(f.chatLog.Page())
+	}
+}
+
+// layoutChat fills the chat log from a view and places it and the chat line in the bottom left
+func (f *frontend) layoutChat(v *game.View) {
+	lineHeight := f.ui.LineHeight()
+	f.chatLog.Hidden = len(v.ChatLog) == 0
+	f.chatLog.SetBounds(pixel.R(10, 70, 410, 80+chatLogLines*lineHeight))
+	rows := make([]ui.Row, len(v.ChatLog))
+	for i, line := range v.ChatLog {
+		rows[i] = ui.Row{Text: line.Text, Color: chatColors[line.Channel]}
+	}
+	f.chatLog.SetRows(rows)
+	f.chatInput.SetBounds(pixel.R(10, 66-lineHeight-10, 410, 66))
+}
@@ -0,0 +1,86 @@
+package game
+
+import (
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/mmogo/mmo/client/network"
+	"github.com/mmogo/mmo/shared"
+)
+
+const (
+	chatLogSize = 200 // lines kept for scrolling back
+	speechLines = 5   // lines shown over a speaker at once
+	speechTime  = time.Second * 5
+)
+
+// speechLine is a line said out loud, shown over the speaker until it expires
+type speechLine struct {
+	text  string
+	until time.Time
+}
+
+// ChatLine is a message in the chat log
+type ChatLine struct {
+	Text    string
+	Channel shared.ChatChannel
+}
+
+// handlePlayerSpoke adds a message to the chat log, and shows it
+// in a bubble over the speaker if it was spoken out loud
+func (w *World) handlePlayerSpoke(speech *shared.PlayerSpoke) {
+	chatLog.Info("chat", "channel", speech.Channel, "from", speech.ID, "to", speech.To, "text", speech.Text)
+	w.addChatLine(ChatLine{Text: chatText(w.playerID, speech), Channel: speech.Channel})
+	if speech.Channel != shared.CHAT_SAY && speech.Channel != shared.CHAT_SHOUT {
+		return
+	}
+
+	id := speech.ID
+	w.speechLock.Lock()
+	defer w.speechLock.Unlock()
+	txt := w.playerSpeech[id]
+	if len(txt) >= speechLines {
+		txt = txt[1:]
+	}
+	w.playerSpeech[id] = append(txt, speechLine{text: speech.Text, until: w.now().Add(speechTime)})
+}
+
+// chatText formats a message as seen by the player with the given ID
+func chatText(playerID string, speech *shared.PlayerSpoke) string {
+	switch speech.Channel {
+	case shared.CHAT_SYSTEM:
+		return speech.Text
+	case shared.CHAT_WHISPER:
+		if speech.ID == playerID {
+			return fmt.Sprintf("[to %s] %s", speech.To, speech.Text)
+		}
+		return fmt.Sprintf("[from %s] %s", speech.ID, speech.Text)
+	case shared.CHAT_PARTY:
+		if speech.ID == "" {
+			return "[party] " + speech.Text
+		}
+		return fmt.Sprintf("[party] %s: %s", speech.ID, speech.Text)
+	case shared.CHAT_SHOUT:
+		return fmt.Sprintf("%s shouts: %s", speech.ID, speech.Text)
+	default:
+		return fmt.Sprintf("%s: %s", speech.ID, speech.Text)
+	}
+}
+
+func (w *World) addChatLine(line ChatLine) {
+	w.speechLock.Lock()
+	defer w.speechLock.Unlock()
+	w.chatLog = append(w.chatLog, line)
+	if len(w.chatLog) > chatLogSize {
+		w.chatLog = w.chatLog[len(w.chatLog)-chatLogSize:]
+	}
+}
+
+// Speak sends a chat message, which may be a command such as /w or /party
+func (w *World) Speak(text string, conn net.Conn) error {
+	if text == "" {
+		return nil
+	}
+	return network.RequestSpeak(text, conn)
+}
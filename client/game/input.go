@@ -0,0 +1,99 @@
+package game
+
+import (
+	"net"
+
+	"github.com/faiface/pixel"
+	"github.com/mmogo/mmo/client/network"
+	"github.com/mmogo/mmo/shared"
+	"github.com/mmogo/mmo/shared/pathfind"
+)
+
+// Ready returns the local player to idle unless a triggered action, such as a shot,
+// is still playing out, and reports whether the player can act again. The dead can't
+// act until the server respawns them
+func (w *World) Ready() bool {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	if player := w.players[w.playerID]; player != nil && player.Action == shared.A_DEAD {
+		w.action = shared.A_DEAD
+		return false
+	}
+	if w.now().Before(w.actionUntil) {
+		return false
+	}
+	w.action = shared.A_IDLE
+	return true
+}
+
+// Move steps the local player in a direction through the world, predicting the move until the server confirms it
+func (w *World) Move(direction pixel.Vec, conn net.Conn) error {
+	unit := direction.Unit()
+	w.lock.Lock()
+	loc := w.players[w.playerID].Position
+	step := unit.Scaled(w.moveSpeed)
+	w.facing = shared.ScreenDirection(unit)
+	w.action = shared.A_WALK
+	w.lock.Unlock()
+	// the server only turns players trying to walk into blocked tiles
+	if !w.tileMap.BlockedAt(loc.Add(step)) {
+		w.queueSimulation(func() {
+			w.setPlayerPosition(w.playerID, loc.Add(step))
+		})
+	}
+	return network.RequestMove(unit, conn)
+}
+
+// WalkTo plans a path around blocked tiles for the local player to a point, which
+// FollowPath then walks. It reports false, and tells the player, if there is no way there
+func (w *World) WalkTo(dest pixel.Vec) bool {
+	w.lock.RLock()
+	from := w.players[w.playerID].Position
+	w.lock.RUnlock()
+	path, ok := pathfind.Path(w.tileMap, from, dest, pathfind.DefaultMaxNodes)
+	if !ok {
+		w.spellLock.Lock()
+		w.notice = &notice{text: "can't get there", until: w.now().Add(noticeTime)}
+		w.spellLock.Unlock()
+	}
+	w.lock.Lock()
+	w.path = path
+	w.lock.Unlock()
+	return ok
+}
+
+// StopWalking drops the path set by WalkTo
+func (w *World) StopWalking() {
+	w.lock.Lock()
+	w.path = nil
+	w.lock.Unlock()
+}
+
+// FollowPath moves the local player one step toward the next point on the path set by WalkTo
+func (w *World) FollowPath(conn net.Conn) error {
+	w.lock.Lock()
+	pos := w.players[w.playerID].Position
+	// a point counts as reached once the next move would overshoot it
+	for len(w.path) > 0 && w.path[0].Sub(pos).Len() <= w.moveSpeed/2 {
+		w.path = w.path[1:]
+	}
+	if len(w.path) == 0 {
+		w.path = nil
+		w.lock.Unlock()
+		return nil
+	}
+	direction := w.path[0].Sub(pos)
+	w.lock.Unlock()
+	return w.Move(direction, conn)
+}
+
+// Shoot fires a projectile in a direction through the world
+func (w *World) Shoot(direction pixel.Vec, conn net.Conn) error {
+	aim := direction.Unit()
+	w.lock.Lock()
+	w.facing = shared.ScreenDirection(aim)
+	w.action = shared.A_SHOOT
+	w.actionUntil = w.now().Add(w.shootCooldown)
+	w.lock.Unlock()
+	return network.RequestShoot(aim, conn)
+}
@@ -0,0 +1,228 @@
+package game
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/faiface/pixel"
+	"github.com/mmogo/mmo/shared"
+)
+
+func TestIntents(t *testing.T) {
+	book := &shared.SpellBook{Spells: []*shared.Spell{{Name: "fireball", CastTime: 1}}}
+	item := func(id string, pos pixel.Vec) *shared.Update {
+		return &shared.Update{EntitySpawned: &shared.EntitySpawned{Entity: &shared.Entity{
+			ID: id, Kind: shared.E_ITEM, Position: pos, Item: &shared.Item{ItemID: "potion", Count: 1},
+		}}}
+	}
+
+	tests := []struct {
+		name    string
+		updates []*shared.Update
+		blocked []shared.Tile
+		act     func(w *World, conn net.Conn) error
+		want    func(t *testing.T, requests []*shared.Request)
+		check   func(t *testing.T, w *World)
+	}{
+		{
+			name: "move is requested and predicted",
+			act: func(w *World, conn net.Conn) error {
+				return w.Move(pixel.V(3, 0), conn)
+			},
+			want: func(t *testing.T, requests []*shared.Request) {
+				if len(requests) != 1 || requests[0].MoveRequest == nil || requests[0].MoveRequest.Direction != pixel.V(1, 0) {
+					t.Errorf("sent %+v, want a move right", requests)
+				}
+			},
+			check: func(t *testing.T, w *World) {
+				w.Step(0.1)
+				v := w.View()
+				if got := v.Player().Position; got != pixel.V(2, 0) {
+					t.Errorf("predicted at %v, want (2, 0)", got)
+				}
+				if v.Facing != shared.ScreenDirection(pixel.V(1, 0)) || v.Action != shared.A_WALK {
+					t.Errorf("facing %v doing %v, want walking right", v.Facing, v.Action)
+				}
+			},
+		},
+		{
+			name:    "move into a blocked tile is requested but not predicted",
+			updates: []*shared.Update{{EntityMoved: &shared.EntityMoved{ID: "me", NewPosition: pixel.V(31, 0)}}},
+			blocked: []shared.Tile{{X: 1, Y: 0}},
+			act: func(w *World, conn net.Conn) error {
+				return w.Move(pixel.V(1, 0), conn)
+			},
+			want: func(t *testing.T, requests []*shared.Request) {
+				if len(requests) != 1 || requests[0].MoveRequest == nil {
+					t.Errorf("sent %+v, want a move", requests)
+				}
+			},
+			check: func(t *testing.T, w *World) {
+				w.Step(0.1)
+				if got := w.View().Player().Position; got != pixel.V(31, 0) {
+					t.Errorf("predicted at %v, want to stay at (31, 0)", got)
+				}
+			},
+		},
+		{
+			name: "shooting waits for the shot to play out",
+			act: func(w *World, conn net.Conn) error {
+				return w.Shoot(pixel.V(0, 5), conn)
+			},
+			want: func(t *testing.T, requests []*shared.Request) {
+				if len(requests) != 1 || requests[0].ShootRequest == nil || requests[0].ShootRequest.Direction != pixel.V(0, 1) {
+					t.Errorf("sent %+v, want a shot up", requests)
+				}
+			},
+			check: func(t *testing.T, w *World) {
+				if w.Ready() {
+					t.Error("ready straight after shooting")
+				}
+				if action := w.View().Action; action != shared.A_SHOOT {
+					t.Errorf("doing %v, want shooting", action)
+				}
+			},
+		},
+		{
+			name:    "shots wait for the server's cooldown",
+			updates: []*shared.Update{{GameSettings: &shared.GameSettings{MoveSpeed: 2, ShootCooldown: 2}}},
+			act: func(w *World, conn net.Conn) error {
+				return w.Shoot(pixel.V(1, 0), conn)
+			},
+			want: func(t *testing.T, requests []*shared.Request) {
+				if len(requests) != 1 || requests[0].ShootRequest == nil {
+					t.Errorf("sent %+v, want a shot", requests)
+				}
+			},
+			check: func(t *testing.T, w *World) {
+				w.clock = func() time.Time { return start.Add(time.Second) }
+				if w.Ready() {
+					t.Error("ready a second into a 2s cooldown")
+				}
+				w.clock = func() time.Time { return start.Add(2 * time.Second) }
+				if !w.Ready() {
+					t.Error("not ready once the cooldown is over")
+				}
+			},
+		},
+		{
+			name:    "empty spell slots cast nothing",
+			updates: []*shared.Update{{SpellBook: book}},
+			act: func(w *World, conn net.Conn) error {
+				return w.Cast(1, pixel.V(10, 0), conn)
+			},
+			want: func(t *testing.T, requests []*shared.Request) {
+				if len(requests) != 0 {
+					t.Errorf("sent %+v, want nothing", requests)
+				}
+			},
+		},
+		{
+			name: "spells are cast at a point in the world",
+			updates: []*shared.Update{
+				{SpellBook: book},
+				{EntityMoved: &shared.EntityMoved{ID: "me", NewPosition: pixel.V(5, 5)}},
+			},
+			act: func(w *World, conn net.Conn) error {
+				return w.Cast(0, pixel.V(10, 0), conn)
+			},
+			want: func(t *testing.T, requests []*shared.Request) {
+				if len(requests) != 1 || requests[0].CastRequest == nil {
+					t.Fatalf("sent %+v, want a cast", requests)
+				}
+				if cast := requests[0].CastRequest; cast.Spell != "fireball" || cast.Target != pixel.V(15, 5) {
+					t.Errorf("cast %v at %v, want fireball at (15, 5)", cast.Spell, cast.Target)
+				}
+			},
+			check: func(t *testing.T, w *World) {
+				if w.Ready() {
+					t.Error("ready while casting")
+				}
+			},
+		},
+		{
+			name: "nothing is said for empty chat",
+			act: func(w *World, conn net.Conn) error {
+				return w.Speak("", conn)
+			},
+			want: func(t *testing.T, requests []*shared.Request) {
+				if len(requests) != 0 {
+					t.Errorf("sent %+v, want nothing", requests)
+				}
+			},
+		},
+		{
+			name: "chat is sent as typed",
+			act: func(w *World, conn net.Conn) error {
+				return w.Speak("/w bob hi", conn)
+			},
+			want: func(t *testing.T, requests []*shared.Request) {
+				if len(requests) != 1 || requests[0].SpeakRequest == nil || requests[0].SpeakRequest.Text != "/w bob hi" {
+					t.Errorf("sent %+v, want the text", requests)
+				}
+			},
+		},
+		{
+			name: "the nearest item in reach is picked up",
+			updates: []*shared.Update{
+				item("item-1", pixel.V(60, 0)),
+				item("item-2", pixel.V(0, 20)),
+				item("item-3", pixel.V(0, 10+shared.PickupRadius)),
+			},
+			act: func(w *World, conn net.Conn) error {
+				return w.PickupNearest(conn)
+			},
+			want: func(t *testing.T, requests []*shared.Request) {
+				if len(requests) != 1 || requests[0].PickupRequest == nil || requests[0].PickupRequest.EntityID != "item-2" {
+					t.Errorf("sent %+v, want to pick up item-2", requests)
+				}
+			},
+		},
+		{
+			name:    "items out of reach are left",
+			updates: []*shared.Update{item("item-1", pixel.V(shared.PickupRadius+1, 0))},
+			act: func(w *World, conn net.Conn) error {
+				return w.PickupNearest(conn)
+			},
+			want: func(t *testing.T, requests []*shared.Request) {
+				if len(requests) != 0 {
+					t.Errorf("sent %+v, want nothing", requests)
+				}
+			},
+		},
+		{
+			name:    "the dead can't act",
+			updates: []*shared.Update{{EntityMoved: &shared.EntityMoved{ID: "me", Action: shared.A_DEAD}}},
+			act: func(w *World, conn net.Conn) error {
+				return nil
+			},
+			want: func(t *testing.T, requests []*shared.Request) {},
+			check: func(t *testing.T, w *World) {
+				if w.Ready() {
+					t.Error("ready while dead")
+				}
+				if action := w.View().Action; action != shared.A_DEAD {
+					t.Errorf("doing %v, want dead", action)
+				}
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w, _ := newTestWorld()
+			for _, update := range test.updates {
+				w.ApplyUpdate(update)
+			}
+			for _, tile := range test.blocked {
+				w.TileMap().SetBlocked(tile, true)
+			}
+			test.want(t, sent(t, func(conn net.Conn) error {
+				return test.act(w, conn)
+			}))
+			if test.check != nil {
+				test.check(t, w)
+			}
+		})
+	}
+}
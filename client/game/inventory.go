@@ -0,0 +1,51 @@
+package game
+
+import (
+	"net"
+
+	"github.com/mmogo/mmo/client/network"
+	"github.com/mmogo/mmo/shared"
+)
+
+func (w *World) handleInventoryUpdated(updated *shared.InventoryUpdated) {
+	w.inventoryLock.Lock()
+	defer w.inventoryLock.Unlock()
+	w.inventory = updated.Inventory
+}
+
+func (w *World) handleEquipmentChanged(changed *shared.EquipmentChanged) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	if player, ok := w.players[changed.ID]; ok {
+		player.Equipment = changed.Equipment
+	}
+}
+
+// PickupNearest picks up the nearest item in reach of the local player, if there is one
+func (w *World) PickupNearest(conn net.Conn) error {
+	w.lock.RLock()
+	pos := w.players[w.playerID].Position
+	nearestID := ""
+	nearest := shared.PickupRadius
+	for id, entity := range w.entities {
+		if entity.Kind != shared.E_ITEM {
+			continue
+		}
+		if d := entity.Position.Sub(pos).Len(); d <= nearest {
+			nearestID, nearest = id, d
+		}
+	}
+	w.lock.RUnlock()
+	if nearestID == "" {
+		return nil
+	}
+	return network.RequestPickup(nearestID, conn)
+}
+
+func (w *World) Equip(itemID string, conn net.Conn) error {
+	return network.RequestEquip(itemID, conn)
+}
+
+func (w *World) Unequip(slot shared.EquipSlot, conn net.Conn) error {
+	return network.RequestUnequip(slot, conn)
+}
@@ -0,0 +1,230 @@
+package game
+
+import (
+	"compress/gzip"
+	"encoding/gob"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/mmogo/mmo/shared"
+)
+
+// recordingVersion changes whenever the recording format does
+const recordingVersion = 1
+
+// A recording is a gzipped stream of gob values: a recordingHeader,
+// then a recordedUpdate for each update the world received
+
+type recordingHeader struct {
+	Version  int
+	PlayerID string
+	Started  time.Time
+}
+
+type recordedUpdate struct {
+	At     time.Duration // since recording started
+	Update *shared.Update
+}
+
+// Recorder writes the updates a world receives to a file, to be watched again with a Replay
+type Recorder struct {
+	lock      sync.Mutex
+	file      *os.File
+	zip       *gzip.Writer
+	enc       *gob.Encoder
+	started   time.Time
+	lastFlush time.Time
+}
+
+// NewRecorder starts a recording of the updates sent to a player
+func NewRecorder(path, playerID string) (*Recorder, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+	r := &Recorder{file: f, zip: gzip.NewWriter(f), started: time.Now()}
+	r.lastFlush = r.started
+	r.enc = gob.NewEncoder(r.zip)
+	if err := r.enc.Encode(&recordingHeader{Version: recordingVersion, PlayerID: playerID, Started: r.started}); err != nil {
+		f.Close()
+		return nil, err
+	}
+	return r, nil
+}
+
+// Record adds an update to the recording. The recording is flushed to the file
+// about once a second, so little is lost if the client dies
+func (r *Recorder) Record(update *shared.Update) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	now := time.Now()
+	if err := r.enc.Encode(&recordedUpdate{At: now.Sub(r.started), Update: update}); err != nil {
+		return err
+	}
+	if now.Sub(r.lastFlush) >= time.Second {
+		r.lastFlush = now
+		return r.zip.Flush()
+	}
+	return nil
+}
+
+// Close finishes the recording
+func (r *Recorder) Close() error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if err := r.zip.Close(); err != nil {
+		r.file.Close()
+		return err
+	}
+	return r.file.Close()
+}
+
+// Replay plays a recording back through a world, which it rebuilds from the start to seek backwards.
+// Time in the world runs as it did when recorded, at the replay's speed
+type Replay struct {
+	playerID string
+	started  time.Time
+	updates  []*recordedUpdate
+
+	world  *World
+	next   int           // index of the next update to apply
+	pos    time.Duration // how far into the recording the world is
+	speed  float64
+	paused bool
+}
+
+// LoadReplay reads a recording made by a Recorder
+func LoadReplay(path string) (*Replay, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	zip, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %v", path, err)
+	}
+	dec := gob.NewDecoder(zip)
+	var header recordingHeader
+	if err := dec.Decode(&header); err != nil {
+		return nil, fmt.Errorf("reading %s: %v", path, err)
+	}
+	if header.Version != recordingVersion {
+		return nil, fmt.Errorf("%s is a version %v recording, this client replays version %v", path, header.Version, recordingVersion)
+	}
+	r := &Replay{playerID: header.PlayerID, started: header.Started, speed: 1}
+	for {
+		u := &recordedUpdate{}
+		err := dec.Decode(u)
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			// recordings end wherever the client stopped, maybe part way through an update
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading %s: %v", path, err)
+		}
+		r.updates = append(r.updates, u)
+	}
+	r.restart()
+	return r, nil
+}
+
+// restart rebuilds the world as it was when recording started
+func (r *Replay) restart() {
+	r.world = NewWorld(r.playerID)
+	r.world.spectating = true
+	r.world.clock = func() time.Time {
+		return r.started.Add(r.pos)
+	}
+	r.next = 0
+	r.pos = 0
+}
+
+// World is the world being replayed. It is replaced when seeking backwards
+func (r *Replay) World() *World {
+	return r.world
+}
+
+// Length is how long the recording runs
+func (r *Replay) Length() time.Duration {
+	if len(r.updates) == 0 {
+		return 0
+	}
+	return r.updates[len(r.updates)-1].At
+}
+
+// Position is how far into the recording playback is
+func (r *Replay) Position() time.Duration {
+	return r.pos
+}
+
+// Started is when the recording was made
+func (r *Replay) Started() time.Time {
+	return r.started
+}
+
+// Speed is how many times faster than real time playback runs
+func (r *Replay) Speed() float64 {
+	return r.speed
+}
+
+// SetSpeed changes how many times faster than real time playback runs
+func (r *Replay) SetSpeed(speed float64) {
+	r.speed = speed
+}
+
+// Paused reports whether playback is paused
+func (r *Replay) Paused() bool {
+	return r.paused
+}
+
+// SetPaused pauses or resumes playback
+func (r *Replay) SetPaused(paused bool) {
+	r.paused = paused
+}
+
+// Advance plays dt seconds of real time, scaled by the speed, unless paused
+func (r *Replay) Advance(dt float64) {
+	if r.paused {
+		return
+	}
+	r.Seek(r.pos + time.Duration(dt*r.speed*float64(time.Second)))
+}
+
+// Seek moves playback to a point in the recording, applying the updates received
+// by then and stepping the world between them as the client did
+func (r *Replay) Seek(pos time.Duration) {
+	if pos < 0 {
+		pos = 0
+	}
+	if length := r.Length(); pos > length {
+		pos = length
+	}
+	if pos < r.pos {
+		r.restart()
+	}
+	// updates are recorded in order, so the first not yet due is found by search
+	end := r.next + sort.Search(len(r.updates)-r.next, func(i int) bool {
+		return r.updates[r.next+i].At > pos
+	})
+	for ; r.next < end; r.next++ {
+		u := r.updates[r.next]
+		r.step(u.At)
+		r.world.ApplyUpdate(u.Update)
+	}
+	r.step(pos)
+}
+
+// step runs the world on to a point in the recording
+func (r *Replay) step(pos time.Duration) {
+	if pos <= r.pos {
+		return
+	}
+	dt := (pos - r.pos).Seconds()
+	r.pos = pos
+	r.world.Step(dt)
+}
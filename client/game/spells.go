@@ -0,0 +1,134 @@
+package game
+
+import (
+	"fmt"
+	"math"
+	"net"
+	"time"
+
+	"github.com/faiface/pixel"
+	"github.com/mmogo/mmo/client/network"
+	"github.com/mmogo/mmo/shared"
+)
+
+const noticeTime = time.Second * 2
+
+// Cast is the local player's spell in progress
+type Cast struct {
+	Spell    string
+	Started  time.Time
+	Duration time.Duration
+}
+
+// Progress is how far through the cast is at a time, from 0 to 1
+func (c *Cast) Progress(now time.Time) float64 {
+	if c.Duration == 0 {
+		return 1
+	}
+	return math.Min(float64(now.Sub(c.Started))/float64(c.Duration), 1)
+}
+
+// SpellEffect is the area of a resolved spell, shown briefly
+type SpellEffect struct {
+	Spell  string
+	Target pixel.Vec
+	Radius float64
+	Until  time.Time
+}
+
+// notice is a message for the player, such as why a cast failed
+type notice struct {
+	text  string
+	until time.Time
+}
+
+func (w *World) handleSpellBook(book *shared.SpellBook) {
+	w.spellLock.Lock()
+	defer w.spellLock.Unlock()
+	w.spells = book.Spells
+}
+
+func (w *World) handleCastStarted(started *shared.CastStarted) {
+	if started.ID != w.playerID {
+		return
+	}
+	w.spellLock.Lock()
+	defer w.spellLock.Unlock()
+	w.cast = &Cast{
+		Spell:    started.Spell,
+		Started:  w.now(),
+		Duration: time.Duration(started.CastTime * float64(time.Second)),
+	}
+}
+
+func (w *World) handleCastFailed(failed *shared.CastFailed) {
+	w.spellLock.Lock()
+	defer w.spellLock.Unlock()
+	if w.cast != nil && w.cast.Spell == failed.Spell {
+		w.cast = nil
+	}
+	w.notice = &notice{
+		text:  fmt.Sprintf("%s: %s", failed.Spell, failed.Reason),
+		until: w.now().Add(noticeTime),
+	}
+}
+
+func (w *World) handleSpellResolved(resolved *shared.SpellResolved) {
+	w.spellLock.Lock()
+	defer w.spellLock.Unlock()
+	if resolved.ID == w.playerID {
+		w.cast = nil
+	}
+	w.spellEffects = append(w.spellEffects, &SpellEffect{
+		Spell:  resolved.Spell,
+		Target: resolved.Target,
+		Radius: resolved.Radius,
+		Until:  w.now().Add(time.Millisecond * 500),
+	})
+}
+
+func (w *World) handleNotice(n *shared.Notice) {
+	w.spellLock.Lock()
+	defer w.spellLock.Unlock()
+	w.notice = &notice{
+		text:  n.Text,
+		until: w.now().Add(noticeTime),
+	}
+}
+
+func (w *World) handlePlayerLevelled(levelled *shared.PlayerLevelled) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	if player, ok := w.players[levelled.ID]; ok {
+		player.Level = levelled.Level
+	}
+}
+
+func (w *World) handlePlayerResources(resources *shared.PlayerResources) {
+	w.spellLock.Lock()
+	defer w.spellLock.Unlock()
+	w.resources = resources
+	now := w.now()
+	w.cooldowns = make(map[string]time.Time)
+	for name, remaining := range resources.Cooldowns {
+		w.cooldowns[name] = now.Add(time.Duration(remaining * float64(time.Second)))
+	}
+}
+
+// Cast casts the spell in a slot of the spell book at a point in the world, given relative to the local player
+func (w *World) Cast(slot int, aim pixel.Vec, conn net.Conn) error {
+	w.spellLock.RLock()
+	spells := w.spells
+	w.spellLock.RUnlock()
+	if slot < 0 || slot >= len(spells) {
+		return nil
+	}
+	spell := spells[slot]
+	w.lock.Lock()
+	w.facing = shared.ScreenDirection(aim)
+	w.action = shared.A_SPELL
+	w.actionUntil = w.now().Add(time.Duration(spell.CastTime * float64(time.Second)))
+	target := w.players[w.playerID].Position.Add(aim)
+	w.lock.Unlock()
+	return network.RequestCast(spell.Name, target, conn)
+}
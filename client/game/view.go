@@ -0,0 +1,118 @@
+package game
+
+import (
+	"time"
+
+	"github.com/faiface/pixel"
+	"github.com/mmogo/mmo/shared"
+)
+
+// Renderer draws views of the world. The game client renders with pixelgl,
+// while headless users of a World, such as bots, need not render at all
+type Renderer interface {
+	// Render draws a view, dt seconds after the last one
+	Render(v *View, dt float64) error
+}
+
+// View is a snapshot of the world to draw. It is a copy, so a renderer
+// can read it freely while updates carry on arriving
+type View struct {
+	Time     time.Time
+	PlayerID string
+	Players  map[string]*shared.ClientPlayer
+	Entities map[string]*shared.Entity
+	Facing   shared.Direction // the local player's animation
+	Action   shared.Action
+	// Spectating is set in replays, where the local player is drawn as the server saw them
+	Spectating bool
+	Path       []pixel.Vec // points the local player is walking through, empty unless clicked to move
+
+	Speech  map[string][]string // lines over each speaking player, oldest first
+	ChatLog []ChatLine
+
+	Spells       []*shared.Spell
+	Resources    *shared.PlayerResources // nil until the server sends them
+	Cooldowns    map[string]time.Time    // when each spell is ready again
+	Cast         *Cast                   // nil unless casting
+	SpellEffects []SpellEffect
+	Notice       string // empty unless there is something to tell the player
+
+	Inventory *shared.Inventory
+}
+
+// View takes a snapshot of the world
+func (w *World) View() *View {
+	v := &View{
+		Time:     w.now(),
+		PlayerID: w.playerID,
+		Players:  make(map[string]*shared.ClientPlayer),
+		Entities: make(map[string]*shared.Entity),
+		Speech:   make(map[string][]string),
+	}
+
+	w.lock.RLock()
+	for id, player := range w.players {
+		p := *player
+		p.Entity = player.Entity.Copy()
+		v.Players[id] = &p
+	}
+	for id, entity := range w.entities {
+		v.Entities[id] = entity.Copy()
+	}
+	v.Facing, v.Action = w.facing, w.action
+	v.Spectating = w.spectating
+	v.Path = append([]pixel.Vec{}, w.path...)
+	w.lock.RUnlock()
+
+	w.speechLock.RLock()
+	for id, lines := range w.playerSpeech {
+		for _, line := range lines {
+			if v.Time.Before(line.until) {
+				v.Speech[id] = append(v.Speech[id], line.text)
+			}
+		}
+	}
+	v.ChatLog = append([]ChatLine{}, w.chatLog...)
+	w.speechLock.RUnlock()
+
+	w.spellLock.RLock()
+	v.Spells = w.spells
+	v.Resources = w.resources
+	v.Cooldowns = make(map[string]time.Time, len(w.cooldowns))
+	for name, ready := range w.cooldowns {
+		v.Cooldowns[name] = ready
+	}
+	if w.cast != nil {
+		c := *w.cast
+		v.Cast = &c
+	}
+	for _, effect := range w.spellEffects {
+		v.SpellEffects = append(v.SpellEffects, *effect)
+	}
+	if w.notice != nil && v.Time.Before(w.notice.until) {
+		v.Notice = w.notice.text
+	}
+	w.spellLock.RUnlock()
+
+	w.inventoryLock.RLock()
+	v.Inventory = w.inventory
+	w.inventoryLock.RUnlock()
+	return v
+}
+
+// Player returns the local player
+func (v *View) Player() *shared.ClientPlayer {
+	return v.Players[v.PlayerID]
+}
+
+// Animation returns the facing and action to draw a player with.
+// Other players are shown idle once their moves stop arriving
+func (v *View) Animation(player *shared.ClientPlayer) (shared.Direction, shared.Action) {
+	if player.ID == v.PlayerID && !v.Spectating {
+		return v.Facing, v.Action
+	}
+	if player.Action == shared.A_WALK && v.Time.Sub(player.LastMoved) > time.Millisecond*250 {
+		return player.Facing, shared.A_IDLE
+	}
+	return player.Facing, player.Action
+}
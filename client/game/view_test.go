@@ -0,0 +1,142 @@
+package game
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/faiface/pixel"
+	"github.com/mmogo/mmo/shared"
+)
+
+func TestViewIsACopy(t *testing.T) {
+	w, _ := newTestWorld()
+	w.ApplyUpdate(&shared.Update{EntitySpawned: &shared.EntitySpawned{Entity: &shared.Entity{ID: "npc-1", Kind: shared.E_NPC, Position: pixel.V(10, 10)}}})
+	w.ApplyUpdate(&shared.Update{EntityMoved: &shared.EntityMoved{ID: "bob", NewPosition: pixel.V(20, 20)}})
+	w.ApplyUpdate(&shared.Update{PlayerSpoke: &shared.PlayerSpoke{ID: "bob", Text: "hello", Channel: shared.CHAT_SAY}})
+
+	v := w.View()
+	v.Player().Position = pixel.V(100, 100)
+	v.Entities["npc-1"].Position = pixel.V(100, 100)
+	delete(v.Players, "bob")
+	v.ChatLog[0].Text = "changed"
+
+	again := w.View()
+	if got := again.Player().Position; got != pixel.ZV {
+		t.Errorf("local player moved to %v by changing a view", got)
+	}
+	if got := again.Entities["npc-1"].Position; got != pixel.V(10, 10) {
+		t.Errorf("npc moved to %v by changing a view", got)
+	}
+	if _, ok := again.Players["bob"]; !ok {
+		t.Error("bob removed by changing a view")
+	}
+	if got := again.ChatLog[0].Text; got != "bob: hello" {
+		t.Errorf("chat log changed to %q by changing a view", got)
+	}
+}
+
+func TestViewExpires(t *testing.T) {
+	tests := []struct {
+		name    string
+		after   time.Duration
+		speech  []string
+		notice  string
+		chatLog int
+	}{
+		{
+			name:    "just said",
+			after:   0,
+			speech:  []string{"hello"},
+			notice:  "too far away",
+			chatLog: 1,
+		},
+		{
+			name:    "notice gone",
+			after:   noticeTime,
+			speech:  []string{"hello"},
+			chatLog: 1,
+		},
+		{
+			name:    "speech gone, still in the log",
+			after:   speechTime,
+			chatLog: 1,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w, clock := newTestWorld()
+			w.ApplyUpdate(&shared.Update{PlayerSpoke: &shared.PlayerSpoke{ID: "bob", Text: "hello", Channel: shared.CHAT_SAY}})
+			w.ApplyUpdate(&shared.Update{Notice: &shared.Notice{Text: "too far away"}})
+			clock.Advance(test.after)
+
+			v := w.View()
+			if got := v.Speech["bob"]; !reflect.DeepEqual(got, test.speech) {
+				t.Errorf("bob is saying %q, want %q", got, test.speech)
+			}
+			if v.Notice != test.notice {
+				t.Errorf("notice is %q, want %q", v.Notice, test.notice)
+			}
+			if len(v.ChatLog) != test.chatLog {
+				t.Errorf("%v lines in the chat log, want %v", len(v.ChatLog), test.chatLog)
+			}
+		})
+	}
+}
+
+func TestViewAnimation(t *testing.T) {
+	tests := []struct {
+		name   string
+		id     string
+		moved  shared.Action
+		after  time.Duration
+		facing shared.Direction
+		action shared.Action
+	}{
+		{
+			name:   "walking player",
+			id:     "bob",
+			moved:  shared.A_WALK,
+			after:  100 * time.Millisecond,
+			facing: shared.LEFT,
+			action: shared.A_WALK,
+		},
+		{
+			name:   "player whose moves stopped arriving",
+			id:     "bob",
+			moved:  shared.A_WALK,
+			after:  time.Second,
+			facing: shared.LEFT,
+			action: shared.A_IDLE,
+		},
+		{
+			name:   "dead player stays dead",
+			id:     "bob",
+			moved:  shared.A_DEAD,
+			after:  time.Second,
+			facing: shared.LEFT,
+			action: shared.A_DEAD,
+		},
+		{
+			name:   "local player is drawn as predicted",
+			id:     "me",
+			moved:  shared.A_WALK,
+			after:  time.Second,
+			facing: shared.DOWN,
+			action: shared.A_WALK,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w, clock := newTestWorld()
+			w.ApplyUpdate(&shared.Update{EntityMoved: &shared.EntityMoved{ID: test.id, Facing: shared.LEFT, Action: test.moved}})
+			clock.Advance(test.after)
+
+			v := w.View()
+			facing, action := v.Animation(v.Players[test.id])
+			if facing != test.facing || action != test.action {
+				t.Errorf("drawn facing %v doing %v, want facing %v doing %v", facing, action, test.facing, test.action)
+			}
+		})
+	}
+}
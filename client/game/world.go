@@ -0,0 +1,366 @@
+// Package game is the client's model of the game world. It applies updates from the server,
+// predicts the local player's moves and turns player intents into requests, without drawing
+// anything, so the game client, bots and replays can all share it. Frontends draw snapshots
+// of the world taken with View through a Renderer
+package game
+
+import (
+	"fmt"
+	"image/color"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/faiface/pixel"
+	"github.com/mmogo/mmo/client/network"
+	"github.com/mmogo/mmo/shared"
+	"github.com/mmogo/mmo/shared/logging"
+	"golang.org/x/image/colornames"
+)
+
+var (
+	netLog  = logging.New("net")
+	chatLog = logging.New("chat")
+)
+
+type simulation struct {
+	f       func()
+	created time.Time
+}
+
+// World is the client's view of the game as seen by one player
+type World struct {
+	playerID   string
+	clock      func() time.Time // time.Now, except in replays
+	spectating bool             // the local player is watched rather than controlled
+	recorder   *Recorder        // nil unless recording
+
+	lock          sync.RWMutex // guards the fields below
+	players       map[string]*shared.ClientPlayer
+	entities      map[string]*shared.Entity
+	facing        shared.Direction // the local player's animation, predicted from input
+	action        shared.Action
+	actionUntil   time.Time
+	moveSpeed     float64       // units per move, from the server
+	shootCooldown time.Duration // between shots, from the server
+	path          []pixel.Vec   // waypoints the local player is walking to, set by WalkTo
+	tileMap       *shared.TileMap
+
+	speechLock   sync.RWMutex
+	playerSpeech map[string][]speechLine
+	chatLog      []ChatLine
+
+	simLock        sync.Mutex
+	simulations    []*simulation
+	runSimulations []*simulation
+
+	spellLock    sync.RWMutex
+	spells       []*shared.Spell
+	resources    *shared.PlayerResources
+	cooldowns    map[string]time.Time
+	cast         *Cast
+	spellEffects []*SpellEffect
+	notice       *notice
+
+	inventoryLock sync.RWMutex
+	inventory     *shared.Inventory
+}
+
+// NewWorld returns an empty world for the player with the given ID
+func NewWorld(playerID string) *World {
+	w := new(World)
+	w.playerID = playerID
+	w.clock = time.Now
+	w.players = make(map[string]*shared.ClientPlayer)
+	w.entities = make(map[string]*shared.Entity)
+	w.playerSpeech = make(map[string][]speechLine)
+	w.cooldowns = make(map[string]time.Time)
+	w.moveSpeed = 2
+	w.shootCooldown = time.Millisecond * 500
+	w.tileMap = shared.DefaultTileMap()
+	w.facing = shared.DOWN
+	w.action = shared.A_WALK
+	w.players[playerID] = &shared.ClientPlayer{
+		Entity: &shared.Entity{
+			ID:       playerID,
+			Kind:     shared.E_PLAYER,
+			Position: pixel.ZV,
+		},
+	}
+	return w
+}
+
+// TileMap is the map of which tiles can be walked on
+func (w *World) TileMap() *shared.TileMap {
+	return w.tileMap
+}
+
+// PlayerID is the ID of the local player
+func (w *World) PlayerID() string {
+	return w.playerID
+}
+
+// Record records the updates Listen applies from now on
+func (w *World) Record(r *Recorder) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	w.recorder = r
+}
+
+// Listen applies updates read from the server to the world, sending any errors to errc
+func (w *World) Listen(conn net.Conn, errc chan<- error) {
+	for {
+		msg, err := network.Receive(conn)
+		if err != nil {
+			errc <- err
+			continue
+		}
+		if msg.Update == nil {
+			continue
+		}
+		w.lock.RLock()
+		recorder := w.recorder
+		w.lock.RUnlock()
+		if recorder != nil {
+			if err := recorder.Record(msg.Update); err != nil {
+				errc <- fmt.Errorf("recording update: %v", err)
+			}
+		}
+		w.ApplyUpdate(msg.Update)
+	}
+}
+
+func (w *World) ApplyUpdate(update *shared.Update) {
+	if update == nil {
+		netLog.Warn("nil update")
+		return
+	}
+	if update.EntitySpawned != nil {
+		w.handleEntitySpawned(update.EntitySpawned)
+	}
+	if update.EntityMoved != nil {
+		w.handleEntityMoved(update.EntityMoved)
+	}
+	if update.EntityRemoved != nil {
+		w.handleEntityRemoved(update.EntityRemoved)
+	}
+	if update.PlayerSpoke != nil {
+		w.handlePlayerSpoke(update.PlayerSpoke)
+	}
+	if update.WorldState != nil {
+		w.handleWorldState(update.WorldState)
+	}
+	if update.SpellBook != nil {
+		w.handleSpellBook(update.SpellBook)
+	}
+	if update.CastStarted != nil {
+		w.handleCastStarted(update.CastStarted)
+	}
+	if update.CastFailed != nil {
+		w.handleCastFailed(update.CastFailed)
+	}
+	if update.SpellResolved != nil {
+		w.handleSpellResolved(update.SpellResolved)
+	}
+	if update.PlayerResources != nil {
+		w.handlePlayerResources(update.PlayerResources)
+	}
+	if update.InventoryUpdated != nil {
+		w.handleInventoryUpdated(update.InventoryUpdated)
+	}
+	if update.Notice != nil {
+		w.handleNotice(update.Notice)
+	}
+	if update.EquipmentChanged != nil {
+		w.handleEquipmentChanged(update.EquipmentChanged)
+	}
+	if update.PlayerLevelled != nil {
+		w.handlePlayerLevelled(update.PlayerLevelled)
+	}
+	if update.GameSettings != nil {
+		w.handleGameSettings(update.GameSettings)
+	}
+}
+
+// Step advances the world by dt seconds: predicted moves are applied
+// and projectiles fly on between updates from the server
+func (w *World) Step(dt float64) {
+	w.applySimulations()
+
+	w.lock.Lock()
+	for _, entity := range w.entities {
+		if entity.Kind == shared.E_PROJECTILE {
+			entity.Step(dt)
+		}
+	}
+	w.lock.Unlock()
+
+	now := w.now()
+	w.speechLock.Lock()
+	for id, lines := range w.playerSpeech {
+		for len(lines) > 0 && !now.Before(lines[0].until) {
+			lines = lines[1:]
+		}
+		w.playerSpeech[id] = lines
+	}
+	w.speechLock.Unlock()
+
+	w.spellLock.Lock()
+	active := w.spellEffects[:0]
+	for _, effect := range w.spellEffects {
+		if now.Before(effect.Until) {
+			active = append(active, effect)
+		}
+	}
+	w.spellEffects = active
+	w.spellLock.Unlock()
+}
+
+func (w *World) handleEntitySpawned(spawned *shared.EntitySpawned) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	w.addEntity(spawned.Entity)
+}
+
+// addEntity stores an entity by kind. w.lock must be held
+func (w *World) addEntity(entity *shared.Entity) {
+	if entity.Kind == shared.E_PLAYER {
+		w.players[entity.ID] = &shared.ClientPlayer{
+			Entity: entity,
+			Color:  playerColor(entity),
+		}
+		return
+	}
+	w.entities[entity.ID] = entity
+}
+
+func (w *World) handleEntityMoved(moved *shared.EntityMoved) {
+	w.lock.Lock()
+	entity, ok := w.entities[moved.ID]
+	if ok {
+		entity.Position = moved.NewPosition
+		entity.Facing = moved.Facing
+		entity.Action = moved.Action
+		w.lock.Unlock()
+		return
+	}
+	player := w.playerLocked(moved.ID)
+	player.Position = moved.NewPosition
+	player.Facing = moved.Facing
+	player.Action = moved.Action
+	player.LastMoved = w.now()
+	w.lock.Unlock()
+	if moved.ID == w.playerID {
+		w.reapplySimulations(moved.RequestTime)
+	}
+}
+
+func (w *World) handleEntityRemoved(removed *shared.EntityRemoved) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	delete(w.entities, removed.ID)
+	delete(w.players, removed.ID)
+}
+
+func (w *World) handleGameSettings(settings *shared.GameSettings) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	w.moveSpeed = settings.MoveSpeed
+	w.shootCooldown = time.Duration(settings.ShootCooldown * float64(time.Second))
+}
+
+func (w *World) handleWorldState(worldState *shared.WorldState) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	// a new world state, e.g. after reconnecting, replaces everyone but the local player
+	if worldState.Part == 0 {
+		w.entities = make(map[string]*shared.Entity)
+		w.players = map[string]*shared.ClientPlayer{w.playerID: w.players[w.playerID]}
+	}
+	for _, entity := range worldState.Entities {
+		w.addEntity(entity)
+	}
+}
+
+func (w *World) setPlayerPosition(id string, pos pixel.Vec) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	w.playerLocked(id).Position = pos
+}
+
+// playerLocked returns a player, adding them if they are not known yet. w.lock must be held
+func (w *World) playerLocked(id string) *shared.ClientPlayer {
+	player, ok := w.players[id]
+	if !ok {
+		player = &shared.ClientPlayer{
+			Entity: &shared.Entity{
+				ID:   id,
+				Kind: shared.E_PLAYER,
+			},
+			Color: stringToColor(id),
+		}
+		w.players[id] = player
+	}
+	return player
+}
+
+func (w *World) queueSimulation(f func()) {
+	w.simLock.Lock()
+	w.simulations = append(w.simulations, &simulation{
+		f:       f,
+		created: w.now(),
+	})
+	w.simLock.Unlock()
+}
+
+func (w *World) applySimulations() {
+	w.simLock.Lock()
+	for _, sim := range w.simulations {
+		sim.f()
+		w.runSimulations = append(w.runSimulations, sim)
+	}
+	w.simulations = []*simulation{}
+	w.simLock.Unlock()
+}
+
+func (w *World) reapplySimulations(from time.Time) {
+	w.simLock.Lock()
+	defer w.simLock.Unlock()
+	if len(w.runSimulations) == 0 {
+		return
+	}
+	i := 0
+	for _, sim := range w.runSimulations {
+		if sim.created.After(from) {
+			break
+		}
+		i++
+	}
+	w.simulations = append(w.runSimulations[i:], w.simulations...)
+	w.runSimulations = []*simulation{}
+}
+
+// now is the time in the world, which replays run at their own pace
+func (w *World) now() time.Time {
+	return w.clock()
+}
+
+// playerColor is the tint a player chose for their character, or one picked from their ID
+func playerColor(entity *shared.Entity) color.Color {
+	if entity.Appearance != nil {
+		if c, ok := colornames.Map[entity.Appearance.Color]; ok {
+			return c
+		}
+	}
+	return stringToColor(entity.ID)
+}
+
+func stringToColor(str string) color.Color {
+	colornum := 0
+	for _, s := range str {
+		colornum += int(s)
+	}
+	all := len(colornames.Names)
+	name := colornames.Names[colornum%all]
+	return colornames.Map[name]
+}
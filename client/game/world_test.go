@@ -0,0 +1,321 @@
+package game
+
+import (
+	"net"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/faiface/pixel"
+	"github.com/mmogo/mmo/shared"
+	"golang.org/x/image/colornames"
+)
+
+var start = time.Date(2017, 9, 1, 12, 0, 0, 0, time.UTC)
+
+// testClock is a world clock which only moves when told to
+type testClock struct {
+	now time.Time
+}
+
+func (c *testClock) Now() time.Time {
+	return c.now
+}
+
+func (c *testClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+// newTestWorld returns a world for the player "me" on a clock stopped at start
+func newTestWorld() (*World, *testClock) {
+	clock := &testClock{now: start}
+	w := NewWorld("me")
+	w.clock = clock.Now
+	return w, clock
+}
+
+// sent returns the requests send makes over a connection
+func sent(t *testing.T, send func(conn net.Conn) error) []*shared.Request {
+	client, server := net.Pipe()
+	received := make(chan []*shared.Request)
+	go func() {
+		requests := []*shared.Request{}
+		for {
+			msg, err := shared.GetMessage(server)
+			if err != nil {
+				received <- requests
+				return
+			}
+			requests = append(requests, msg.Request)
+		}
+	}()
+	if err := send(client); err != nil {
+		t.Fatalf("sending: %v", err)
+	}
+	client.Close()
+	return <-received
+}
+
+func TestApplyUpdate(t *testing.T) {
+	goblin := &shared.Entity{ID: "npc-1", Kind: shared.E_NPC, Position: pixel.V(10, 10), NPC: &shared.NPC{Name: "goblin"}}
+	arrow := &shared.Entity{ID: "projectile-2", Kind: shared.E_PROJECTILE, Position: pixel.V(5, 0)}
+	bob := &shared.Entity{ID: "bob", Kind: shared.E_PLAYER, Position: pixel.V(64, 0), Appearance: &shared.Appearance{Color: "plum"}}
+
+	tests := []struct {
+		name    string
+		updates []*shared.Update
+		check   func(t *testing.T, w *World, v *View)
+	}{
+		{
+			name:    "nil update is ignored",
+			updates: []*shared.Update{nil},
+			check: func(t *testing.T, w *World, v *View) {
+				if len(v.Players) != 1 || len(v.Entities) != 0 {
+					t.Errorf("world changed: %v players, %v entities", len(v.Players), len(v.Entities))
+				}
+			},
+		},
+		{
+			name: "spawned players and entities are added",
+			updates: []*shared.Update{
+				{EntitySpawned: &shared.EntitySpawned{Entity: bob.Copy()}},
+				{EntitySpawned: &shared.EntitySpawned{Entity: goblin.Copy()}},
+			},
+			check: func(t *testing.T, w *World, v *View) {
+				player := v.Players["bob"]
+				if player == nil {
+					t.Fatal("bob was not added to the players")
+				}
+				if player.Color != colornames.Map["plum"] {
+					t.Errorf("bob is drawn in %v, want the plum he chose", player.Color)
+				}
+				if _, ok := v.Entities["npc-1"]; !ok {
+					t.Error("goblin was not added to the entities")
+				}
+				if _, ok := v.Players["npc-1"]; ok {
+					t.Error("goblin was added to the players")
+				}
+			},
+		},
+		{
+			name: "moves update entities and players",
+			updates: []*shared.Update{
+				{EntitySpawned: &shared.EntitySpawned{Entity: goblin.Copy()}},
+				{EntityMoved: &shared.EntityMoved{ID: "npc-1", NewPosition: pixel.V(20, 10), Facing: shared.RIGHT, Action: shared.A_WALK}},
+				{EntityMoved: &shared.EntityMoved{ID: "bob", NewPosition: pixel.V(0, 30), Facing: shared.UP, Action: shared.A_WALK}},
+			},
+			check: func(t *testing.T, w *World, v *View) {
+				if got := v.Entities["npc-1"]; got.Position != pixel.V(20, 10) || got.Facing != shared.RIGHT {
+					t.Errorf("goblin at %v facing %v, want (20, 10) facing right", got.Position, got.Facing)
+				}
+				// players are added when first heard of
+				bob := v.Players["bob"]
+				if bob == nil || bob.Position != pixel.V(0, 30) {
+					t.Errorf("bob is %+v, want a player at (0, 30)", bob)
+				}
+			},
+		},
+		{
+			name: "removed entities and players are dropped",
+			updates: []*shared.Update{
+				{EntitySpawned: &shared.EntitySpawned{Entity: bob.Copy()}},
+				{EntitySpawned: &shared.EntitySpawned{Entity: arrow.Copy()}},
+				{EntityRemoved: &shared.EntityRemoved{ID: "bob"}},
+				{EntityRemoved: &shared.EntityRemoved{ID: "projectile-2", HitID: "npc-1"}},
+			},
+			check: func(t *testing.T, w *World, v *View) {
+				if _, ok := v.Players["bob"]; ok {
+					t.Error("bob is still in the world")
+				}
+				if len(v.Entities) != 0 {
+					t.Errorf("entities left: %v", v.Entities)
+				}
+			},
+		},
+		{
+			name: "world state replaces the entities and players",
+			updates: []*shared.Update{
+				{EntitySpawned: &shared.EntitySpawned{Entity: arrow.Copy()}},
+				{EntityMoved: &shared.EntityMoved{ID: "carol", NewPosition: pixel.V(3, 3)}},
+				{WorldState: &shared.WorldState{Entities: []*shared.Entity{goblin.Copy(), bob.Copy()}}},
+			},
+			check: func(t *testing.T, w *World, v *View) {
+				if _, ok := v.Entities["projectile-2"]; ok {
+					t.Error("an entity from before the world state is left")
+				}
+				if _, ok := v.Players["carol"]; ok {
+					t.Error("a player from before the world state is left")
+				}
+				if v.Player() == nil {
+					t.Error("the local player is gone")
+				}
+				if _, ok := v.Entities["npc-1"]; !ok {
+					t.Error("goblin from the world state is missing")
+				}
+				if _, ok := v.Players["bob"]; !ok {
+					t.Error("bob from the world state is missing")
+				}
+			},
+		},
+		{
+			name: "world state sent in parts",
+			updates: []*shared.Update{
+				{EntitySpawned: &shared.EntitySpawned{Entity: arrow.Copy()}},
+				{WorldState: &shared.WorldState{Entities: []*shared.Entity{goblin.Copy()}, Part: 0, Parts: 2}},
+				{WorldState: &shared.WorldState{Entities: []*shared.Entity{bob.Copy()}, Part: 1, Parts: 2}},
+			},
+			check: func(t *testing.T, w *World, v *View) {
+				if _, ok := v.Entities["projectile-2"]; ok {
+					t.Error("an entity from before the world state is left")
+				}
+				if _, ok := v.Entities["npc-1"]; !ok {
+					t.Error("goblin from the first part is missing")
+				}
+				if _, ok := v.Players["bob"]; !ok {
+					t.Error("bob from the second part is missing")
+				}
+			},
+		},
+		{
+			name: "speech is logged and said out loud",
+			updates: []*shared.Update{
+				{PlayerSpoke: &shared.PlayerSpoke{ID: "bob", Text: "hello", Channel: shared.CHAT_SAY}},
+				{PlayerSpoke: &shared.PlayerSpoke{ID: "bob", Text: "psst", Channel: shared.CHAT_WHISPER, To: "me"}},
+			},
+			check: func(t *testing.T, w *World, v *View) {
+				want := []ChatLine{
+					{Text: "bob: hello", Channel: shared.CHAT_SAY},
+					{Text: "[from bob] psst", Channel: shared.CHAT_WHISPER},
+				}
+				if !reflect.DeepEqual(v.ChatLog, want) {
+					t.Errorf("chat log is %v, want %v", v.ChatLog, want)
+				}
+				if got := v.Speech["bob"]; !reflect.DeepEqual(got, []string{"hello"}) {
+					t.Errorf("bob is saying %q, want only what he said out loud", got)
+				}
+			},
+		},
+		{
+			name: "settings, levels and resources are kept",
+			updates: []*shared.Update{
+				{GameSettings: &shared.GameSettings{MoveSpeed: 5, ShootCooldown: 0.25}},
+				{PlayerLevelled: &shared.PlayerLevelled{ID: "me", Level: 3}},
+				{PlayerResources: &shared.PlayerResources{Health: 50, MaxHealth: 100, Cooldowns: map[string]float64{"heal": 2}}},
+			},
+			check: func(t *testing.T, w *World, v *View) {
+				if w.moveSpeed != 5 || w.shootCooldown != 250*time.Millisecond {
+					t.Errorf("move speed is %v and shots every %v, want 5 and 250ms", w.moveSpeed, w.shootCooldown)
+				}
+				if level := v.Player().Level; level != 3 {
+					t.Errorf("level is %v, want 3", level)
+				}
+				if v.Resources == nil || v.Resources.Health != 50 {
+					t.Errorf("resources are %+v, want 50 health", v.Resources)
+				}
+				if ready := v.Cooldowns["heal"]; !ready.Equal(start.Add(2 * time.Second)) {
+					t.Errorf("heal is ready at %v, want 2s after %v", ready, start)
+				}
+			},
+		},
+		{
+			name: "only the local player's casts are shown",
+			updates: []*shared.Update{
+				{CastStarted: &shared.CastStarted{ID: "bob", Spell: "heal", CastTime: 2}},
+				{CastStarted: &shared.CastStarted{ID: "me", Spell: "fireball", CastTime: 1.5}},
+			},
+			check: func(t *testing.T, w *World, v *View) {
+				want := &Cast{Spell: "fireball", Started: start, Duration: 1500 * time.Millisecond}
+				if !reflect.DeepEqual(v.Cast, want) {
+					t.Errorf("cast is %+v, want %+v", v.Cast, want)
+				}
+			},
+		},
+		{
+			name: "a failed cast is cancelled and explained",
+			updates: []*shared.Update{
+				{CastStarted: &shared.CastStarted{ID: "me", Spell: "fireball", CastTime: 1.5}},
+				{CastFailed: &shared.CastFailed{Spell: "fireball", Reason: "interrupted"}},
+			},
+			check: func(t *testing.T, w *World, v *View) {
+				if v.Cast != nil {
+					t.Errorf("still casting %+v", v.Cast)
+				}
+				if v.Notice != "fireball: interrupted" {
+					t.Errorf("notice is %q", v.Notice)
+				}
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w, _ := newTestWorld()
+			for _, update := range test.updates {
+				w.ApplyUpdate(update)
+			}
+			test.check(t, w, w.View())
+		})
+	}
+}
+
+func TestPredictionReappliedAfterCorrection(t *testing.T) {
+	tests := []struct {
+		name      string
+		confirmed int // moves the server has handled when it corrects the player
+		correct   pixel.Vec
+		want      pixel.Vec // after the correction and the next step
+	}{
+		{
+			name:      "all moves confirmed",
+			confirmed: 3,
+			correct:   pixel.V(6, 0),
+			want:      pixel.V(6, 0),
+		},
+		{
+			name:      "moves after the confirmed one are predicted again",
+			confirmed: 1,
+			correct:   pixel.V(2, 0),
+			want:      pixel.V(6, 0),
+		},
+		{
+			name:      "a move the server refused is dropped",
+			confirmed: 3,
+			correct:   pixel.V(4, 0),
+			want:      pixel.V(4, 0),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w, clock := newTestWorld()
+			requested := []time.Time{}
+			sent(t, func(conn net.Conn) error {
+				for i := 0; i < 3; i++ {
+					requested = append(requested, clock.Now())
+					if err := w.Move(pixel.V(1, 0), conn); err != nil {
+						return err
+					}
+					w.Step(0.1)
+					clock.Advance(100 * time.Millisecond)
+				}
+				return nil
+			})
+			if got := w.View().Player().Position; got != pixel.V(6, 0) {
+				t.Fatalf("predicted %v after 3 moves, want (6, 0)", got)
+			}
+
+			w.ApplyUpdate(&shared.Update{EntityMoved: &shared.EntityMoved{
+				ID:          "me",
+				NewPosition: test.correct,
+				Facing:      shared.RIGHT,
+				Action:      shared.A_WALK,
+				RequestTime: requested[test.confirmed-1],
+			}})
+			if got := w.View().Player().Position; got != test.correct {
+				t.Errorf("at %v straight after the correction, want the server's %v", got, test.correct)
+			}
+			w.Step(0.1)
+			if got := w.View().Player().Position; got != test.want {
+				t.Errorf("at %v once predictions are reapplied, want %v", got, test.want)
+			}
+		})
+	}
+}
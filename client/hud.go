@@ -0,0 +1,100 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/faiface/pixel"
+	"github.com/mmogo/mmo/client/game"
+	"github.com/mmogo/mmo/shared"
+	"golang.org/x/image/colornames"
+)
+
+// drawSpellEffects outlines the area of recently resolved spells, in world space
+func (f *frontend) drawSpellEffects(v *game.View) {
+	imd := f.imd
+	imd.Clear()
+	for _, effect := range v.SpellEffects {
+		imd.Color = colornames.Orangered
+		// circles on the ground are squashed like the map
+		imd.Push(shared.MapToIso(effect.Target))
+		imd.Ellipse(pixel.V(effect.Radius, effect.Radius/2), 3)
+	}
+	imd.Draw(f.win)
+}
+
+// drawPath marks where the local player is walking to, in world space.
+// In debug mode the whole path is shown
+func (f *frontend) drawPath(v *game.View) {
+	if len(v.Path) == 0 {
+		return
+	}
+	imd := f.imd
+	imd.Clear()
+	if f.debug {
+		imd.Color = colornames.Lightskyblue
+		imd.Push(shared.MapToIso(v.Player().Position))
+		for _, point := range v.Path {
+			imd.Push(shared.MapToIso(point))
+		}
+		imd.Line(2)
+	}
+	imd.Color = colornames.White
+	imd.Push(shared.MapToIso(v.Path[len(v.Path)-1]))
+	imd.Ellipse(pixel.V(8, 4), 2)
+	imd.Draw(f.win)
+}
+
+// drawHUD draws resources, spell cooldowns and the cast bar in screen space
+func (f *frontend) drawHUD(v *game.View) {
+	win, txt, imd := f.win, f.hudText, f.imd
+	now := v.Time
+	bounds := win.Bounds()
+
+	txt.Clear()
+	txt.Dot = txt.Orig
+	if r := v.Resources; r != nil {
+		fmt.Fprintf(txt, "HP %.0f/%.0f  MP %.0f/%.0f\n", r.Health, r.MaxHealth, r.Mana, r.MaxMana)
+		if r.NextLevelXP > 0 {
+			fmt.Fprintf(txt, "Level %v  XP %v/%v  STR %.0f\n", r.Level, r.XP, r.NextLevelXP, r.Strength)
+		} else {
+			fmt.Fprintf(txt, "Level %v  XP %v  STR %.0f\n", r.Level, r.XP, r.Strength)
+		}
+	}
+	for i, spell := range v.Spells {
+		if i >= spellSlots {
+			break
+		}
+		key := f.keys.describe(castAction(i))
+		if ready, ok := v.Cooldowns[spell.Name]; ok && now.Before(ready) {
+			fmt.Fprintf(txt, "%s %s %.1fs\n", key, spell.Name, ready.Sub(now).Seconds())
+			continue
+		}
+		fmt.Fprintf(txt, "%s %s (%.0f mana)\n", key, spell.Name, spell.ManaCost)
+	}
+	txt.DrawColorMask(win, pixel.IM.Moved(pixel.V(10, bounds.H()-20)), colornames.White)
+
+	imd.Clear()
+	barPos := pixel.V(bounds.W()/2-100, 40)
+	if v.Cast != nil {
+		progress := v.Cast.Progress(now)
+		imd.Color = colornames.Black
+		imd.Push(barPos, barPos.Add(pixel.V(200, 12)))
+		imd.Rectangle(0)
+		imd.Color = colornames.Mediumpurple
+		imd.Push(barPos, barPos.Add(pixel.V(200*progress, 12)))
+		imd.Rectangle(0)
+
+		txt.Clear()
+		txt.Dot = txt.Orig
+		txt.WriteString(v.Cast.Spell)
+		txt.DrawColorMask(win, pixel.IM.Moved(barPos.Add(pixel.V(0, 16))), colornames.White)
+	}
+	imd.Draw(win)
+
+	if v.Notice != "" {
+		txt.Clear()
+		txt.Dot = txt.Orig
+		txt.WriteString(v.Notice)
+		txt.DrawColorMask(win, pixel.IM.Moved(barPos.Add(pixel.V(0, 36))), colornames.Red)
+	}
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"github.com/faiface/pixel"
+	"github.com/mmogo/mmo/shared"
+)
+
+// moveActions are the keys which walk the local player, combining into diagonals
+var moveActions = map[action]shared.Direction{
+	actMoveUp:    shared.UP,
+	actMoveDown:  shared.DOWN,
+	actMoveLeft:  shared.LEFT,
+	actMoveRight: shared.RIGHT,
+}
+
+// processInput turns this frame's keyboard and mouse input into actions of the local player
+func (f *frontend) processInput() error {
+	world := f.world
+	if f.keyScreen.capturing {
+		return f.processKeyCapture()
+	}
+	if err := f.ui.Update(); err != nil {
+		return err
+	}
+	if !f.keyScreen.panel.Hidden {
+		return f.processKeyBindingsInput()
+	}
+	// a widget is being typed into
+	if f.ui.CapturedKeys() {
+		f.processChatScroll()
+		return nil
+	}
+	if f.justPressed(actKeyBindings) {
+		f.openKeyBindings()
+		return nil
+	}
+	if f.justPressed(actDebug) {
+		f.debug = !f.debug
+	}
+	if f.justPressed(actWorldMap) {
+		f.showWorldMap = !f.showWorldMap
+	}
+	// let a triggered action finish animating
+	if !world.Ready() {
+		return nil
+	}
+	if err := f.processMoveInput(); err != nil {
+		return err
+	}
+
+	f.processChatScroll()
+	if f.justPressed(actChat) {
+		f.openChat()
+		return nil
+	}
+
+	// shoot towards the mouse
+	if f.justPressed(actAttack) {
+		return world.Shoot(f.mouseOffset(), f.conn)
+	}
+
+	// cast the spell bound to a pressed key at the mouse
+	for slot := 0; slot < spellSlots; slot++ {
+		if f.justPressed(castAction(slot)) {
+			return world.Cast(slot, f.mouseOffset(), f.conn)
+		}
+	}
+
+	return f.processInventoryInput()
+}
+
+// processMoveInput walks the local player in the direction of the held movement keys,
+// towards the mouse while the move-to-pointer button is held, or along a path to
+// the last point clicked with the move-to-click button
+func (f *frontend) processMoveInput() error {
+	world := f.world
+	if f.justPressed(actMoveToClick) {
+		world.WalkTo(shared.IsoToMap(f.cam.Unproject(f.win.MousePosition())))
+	}
+	sum := pixel.ZV
+	for a, direction := range moveActions {
+		if f.pressed(a) {
+			sum = sum.Add(direction.ToVec())
+		}
+	}
+	if direction := shared.UnitToDirection(sum); direction != shared.DIR_NONE {
+		world.StopWalking()
+		// keys move the way they point on screen
+		return world.Move(shared.IsoToMap(direction.ToVec()), f.conn)
+	}
+	if f.pressed(actMoveToPointer) {
+		world.StopWalking()
+		return world.Move(f.mouseOffset(), f.conn)
+	}
+	return world.FollowPath(f.conn)
+}
+
+// mouseOffset is where the mouse points in the world, relative to the local player
+// at the centre of the window
+func (f *frontend) mouseOffset() pixel.Vec {
+	return shared.IsoToMap(f.centerMatrix.Unproject(f.win.MousePosition()))
+}
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/faiface/pixel"
+	"github.com/mmogo/mmo/client/game"
+	"github.com/mmogo/mmo/client/ui"
+	"github.com/mmogo/mmo/shared"
+)
+
+// processInventoryInput toggles the inventory panel and picks up the nearest item
+func (f *frontend) processInventoryInput() error {
+	if f.justPressed(actInventory) {
+		f.inventory.panel.Hidden = !f.inventory.panel.Hidden
+	}
+	if f.justPressed(actPickup) {
+		return f.world.PickupNearest(f.conn)
+	}
+	return nil
+}
+
+// equipmentLayers returns the sheets to draw over a character for its equipment, loading them on first use
+func (f *frontend) equipmentLayers(equipment *shared.Equipment) ([]pixel.Picture, error) {
+	if equipment == nil {
+		return nil, nil
+	}
+	layers := []pixel.Picture{}
+	for _, slot := range shared.EquipSlots {
+		def, ok := shared.Items[equipment.Get(slot)]
+		if !ok || def.Sheet == "" {
+			continue
+		}
+		sheet, ok := f.sheets[def.Sheet]
+		if !ok {
+			var err error
+			sheet, err = loadPicture(def.Sheet)
+			if err != nil {
+				return nil, err
+			}
+			f.sheets[def.Sheet] = sheet
+		}
+		layers = append(layers, sheet)
+	}
+	return layers, nil
+}
+
+// itemSprite returns the ground sprite of an item, loading it on first use
+func (f *frontend) itemSprite(itemID string) (*pixel.Sprite, error) {
+	def, ok := shared.Items[itemID]
+	if !ok {
+		return nil, fmt.Errorf("unknown item %q", itemID)
+	}
+	if sprite, ok := f.itemSprites[def.Sprite]; ok {
+		return sprite, nil
+	}
+	pic, err := loadPicture(def.Sprite)
+	if err != nil {
+		return nil, err
+	}
+	sprite := pixel.NewSprite(pic, pic.Bounds())
+	f.itemSprites[def.Sprite] = sprite
+	return sprite, nil
+}
+
+// inventoryWindow is the inventory and equipment panel. Its rows are kept and
+// filled in from each view. Clicking an equippable item equips it, clicking a worn
+// item takes it off
+type inventoryWindow struct {
+	panel    *ui.Panel
+	title    *ui.Label
+	items    []*ui.Button // one for each inventory slot
+	worn     *ui.Label
+	equipped []*ui.Button // one for each equipment slot
+}
+
+// addInventory adds the inventory panel, hidden until it is opened
+func (f *frontend) addInventory() {
+	inv := &inventoryWindow{
+		panel: &ui.Panel{Box: ui.Box{Hidden: true}},
+		title: &ui.Label{Text: "Inventory"},
+		worn:  &ui.Label{Text: "Equipment"},
+	}
+	inv.panel.Widgets = append(inv.panel.Widgets, inv.title, inv.worn)
+	for i := 0; i < shared.InventorySize; i++ {
+		b := &ui.Button{Flat: true}
+		inv.items = append(inv.items, b)
+		inv.panel.Widgets = append(inv.panel.Widgets, b)
+	}
+	for range shared.EquipSlots {
+		b := &ui.Button{Flat: true}
+		inv.equipped = append(inv.equipped, b)
+		inv.panel.Widgets = append(inv.panel.Widgets, b)
+	}
+	f.inventory = inv
+	f.ui.Add(inv.panel)
+}
+
+// layoutInventory fills the inventory panel from a view and places it in the top right corner
+func (f *frontend) layoutInventory(v *game.View) {
+	inv := f.inventory
+	if inv.panel.Hidden {
+		return
+	}
+	lineHeight := f.ui.LineHeight()
+	bounds := f.win.Bounds()
+	lines := len(inv.items) + len(inv.equipped) + 3
+	inv.panel.SetBounds(pixel.R(bounds.W()-230, bounds.H()-30-float64(lines)*lineHeight, bounds.W()-10, bounds.H()-10))
+
+	// empty slots are hidden, and the rows of those in use close up
+	widgets := []ui.Widget{inv.title}
+	for i, b := range inv.items {
+		b.OnClick = nil
+		b.Hidden = v.Inventory == nil || i >= len(v.Inventory.Slots)
+		if b.Hidden {
+			continue
+		}
+		widgets = append(widgets, b)
+		slot := v.Inventory.Slots[i]
+		name := slot.ItemID
+		if def, ok := shared.Items[slot.ItemID]; ok {
+			name = def.Name
+			if def.Slot != "" {
+				itemID := def.ID
+				b.OnClick = func() error { return f.world.Equip(itemID, f.conn) }
+			}
+		}
+		b.Text = fmt.Sprintf("%s x%v", name, slot.Count)
+	}
+	widgets = append(widgets, nil, inv.worn)
+	equipment := v.Player().Equipment
+	for i, slot := range shared.EquipSlots {
+		b := inv.equipped[i]
+		widgets = append(widgets, b)
+		name := "-"
+		b.OnClick = nil
+		if equipment != nil && equipment.Get(slot) != "" {
+			name = equipment.Get(slot)
+			if def, ok := shared.Items[name]; ok {
+				name = def.Name
+			}
+			slot := slot
+			b.OnClick = func() error { return f.world.Unequip(slot, f.conn) }
+		}
+		b.Text = fmt.Sprintf("%s: %s", slot, name)
+	}
+	panel := inv.panel.Rect
+	ui.Column(pixel.R(panel.Min.X+5, panel.Min.Y+10, panel.Max.X-5, panel.Max.Y-10), lineHeight, widgets...)
+}
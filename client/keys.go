@@ -0,0 +1,243 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/faiface/pixel/pixelgl"
+)
+
+// action is something the player does with a key or mouse button
+type action string
+
+const (
+	actMoveUp        action = "move-up"
+	actMoveDown      action = "move-down"
+	actMoveLeft      action = "move-left"
+	actMoveRight     action = "move-right"
+	actMoveToPointer action = "move-to-pointer"
+	actMoveToClick   action = "move-to-click"
+	actAttack        action = "attack"
+	actChat          action = "chat"
+	actChatScrollUp  action = "chat-scroll-up"
+	actChatScrollDn  action = "chat-scroll-down"
+	actInventory     action = "inventory"
+	actWorldMap      action = "world-map"
+	actPickup        action = "pickup"
+	actDebug         action = "debug"
+	actKeyBindings   action = "key-bindings"
+)
+
+// castAction casts the spell in a slot of the spell book, counting from zero
+func castAction(slot int) action {
+	return action(fmt.Sprintf("cast-%v", slot+1))
+}
+
+// spellSlots is how many spells can be bound
+const spellSlots = 9
+
+// defaultKeyBindings are used for actions the key bindings file leaves out
+var defaultKeyBindings = func() map[action][]pixelgl.Button {
+	keys := map[action][]pixelgl.Button{
+		actMoveUp:        {pixelgl.KeyW, pixelgl.KeyUp},
+		actMoveDown:      {pixelgl.KeyS, pixelgl.KeyDown},
+		actMoveLeft:      {pixelgl.KeyA, pixelgl.KeyLeft},
+		actMoveRight:     {pixelgl.KeyD, pixelgl.KeyRight},
+		actMoveToPointer: {pixelgl.MouseButtonLeft},
+		actMoveToClick:   {pixelgl.MouseButtonRight},
+		actAttack:        {pixelgl.KeySpace},
+		actChat:          {pixelgl.KeyEnter},
+		actChatScrollUp:  {pixelgl.KeyPageUp},
+		actChatScrollDn:  {pixelgl.KeyPageDown},
+		actInventory:     {pixelgl.KeyI},
+		actWorldMap:      {pixelgl.KeyM},
+		actPickup:        {pixelgl.KeyE},
+		actDebug:         {pixelgl.KeyF2},
+		actKeyBindings:   {pixelgl.KeyF1},
+	}
+	digits := []pixelgl.Button{
+		pixelgl.Key1, pixelgl.Key2, pixelgl.Key3,
+		pixelgl.Key4, pixelgl.Key5, pixelgl.Key6,
+		pixelgl.Key7, pixelgl.Key8, pixelgl.Key9,
+	}
+	for slot, key := range digits {
+		keys[castAction(slot)] = []pixelgl.Button{key}
+	}
+	return keys
+}()
+
+// bindableActions lists the actions in the order the key bindings screen shows them
+var bindableActions = func() []action {
+	actions := []action{
+		actMoveUp, actMoveDown, actMoveLeft, actMoveRight, actMoveToPointer, actMoveToClick,
+		actAttack, actChat, actChatScrollUp, actChatScrollDn,
+		actInventory, actWorldMap, actPickup, actDebug, actKeyBindings,
+	}
+	for slot := 0; slot < spellSlots; slot++ {
+		actions = append(actions, castAction(slot))
+	}
+	return actions
+}()
+
+// buttonNames are the names of keys and mouse buttons in the key bindings file
+var buttonNames = func() map[string]pixelgl.Button {
+	names := map[string]pixelgl.Button{
+		"MouseLeft":    pixelgl.MouseButtonLeft,
+		"MouseRight":   pixelgl.MouseButtonRight,
+		"MouseMiddle":  pixelgl.MouseButtonMiddle,
+		"Space":        pixelgl.KeySpace,
+		"Apostrophe":   pixelgl.KeyApostrophe,
+		"Comma":        pixelgl.KeyComma,
+		"Minus":        pixelgl.KeyMinus,
+		"Period":       pixelgl.KeyPeriod,
+		"Slash":        pixelgl.KeySlash,
+		"Semicolon":    pixelgl.KeySemicolon,
+		"Equal":        pixelgl.KeyEqual,
+		"Escape":       pixelgl.KeyEscape,
+		"Enter":        pixelgl.KeyEnter,
+		"Tab":          pixelgl.KeyTab,
+		"Backspace":    pixelgl.KeyBackspace,
+		"Insert":       pixelgl.KeyInsert,
+		"Delete":       pixelgl.KeyDelete,
+		"Right":        pixelgl.KeyRight,
+		"Left":         pixelgl.KeyLeft,
+		"Down":         pixelgl.KeyDown,
+		"Up":           pixelgl.KeyUp,
+		"PageUp":       pixelgl.KeyPageUp,
+		"PageDown":     pixelgl.KeyPageDown,
+		"Home":         pixelgl.KeyHome,
+		"End":          pixelgl.KeyEnd,
+		"LeftShift":    pixelgl.KeyLeftShift,
+		"LeftControl":  pixelgl.KeyLeftControl,
+		"LeftAlt":      pixelgl.KeyLeftAlt,
+		"RightShift":   pixelgl.KeyRightShift,
+		"RightControl": pixelgl.KeyRightControl,
+		"RightAlt":     pixelgl.KeyRightAlt,
+	}
+	for i := 0; i < 10; i++ {
+		names[fmt.Sprint(i)] = pixelgl.Key0 + pixelgl.Button(i)
+	}
+	for i := 0; i < 26; i++ {
+		names[string(rune('A'+i))] = pixelgl.KeyA + pixelgl.Button(i)
+	}
+	for i := 0; i < 12; i++ {
+		names[fmt.Sprintf("F%v", i+1)] = pixelgl.KeyF1 + pixelgl.Button(i)
+	}
+	return names
+}()
+
+// buttonName returns the name of a key or mouse button in the key bindings file
+func buttonName(button pixelgl.Button) string {
+	for name, b := range buttonNames {
+		if b == button {
+			return name
+		}
+	}
+	return fmt.Sprintf("button %v", int(button))
+}
+
+// keyBindings maps each action to the keys and mouse buttons which trigger it
+type keyBindings struct {
+	path    string // file the bindings are saved to, if any
+	buttons map[action][]pixelgl.Button
+}
+
+// loadKeyBindings reads a key bindings file, a JSON object of action names to lists of button names.
+// Actions the file leaves out keep their default buttons, and a missing file means all the defaults
+func loadKeyBindings(path string) (*keyBindings, error) {
+	k := &keyBindings{path: path, buttons: make(map[action][]pixelgl.Button)}
+	for a, buttons := range defaultKeyBindings {
+		k.buttons[a] = buttons
+	}
+	if path == "" {
+		return k, nil
+	}
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return k, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var file map[string][]string
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("parsing %s: %v", path, err)
+	}
+	for name, buttonList := range file {
+		a := action(name)
+		if _, ok := defaultKeyBindings[a]; !ok {
+			return nil, fmt.Errorf("%s: unknown action %q", path, name)
+		}
+		buttons := []pixelgl.Button{}
+		for _, buttonName := range buttonList {
+			button, ok := buttonNames[buttonName]
+			if !ok {
+				return nil, fmt.Errorf("%s: unknown key %q for %s", path, buttonName, name)
+			}
+			buttons = append(buttons, button)
+		}
+		k.buttons[a] = buttons
+	}
+	return k, nil
+}
+
+// save writes the bindings to their file
+func (k *keyBindings) save() error {
+	if k.path == "" {
+		return nil
+	}
+	file := make(map[string][]string)
+	for a, buttons := range k.buttons {
+		names := []string{}
+		for _, button := range buttons {
+			names = append(names, buttonName(button))
+		}
+		file[string(a)] = names
+	}
+	data, err := json.MarshalIndent(file, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(k.path, data, 0644)
+}
+
+// describe lists the buttons bound to an action
+func (k *keyBindings) describe(a action) string {
+	names := []string{}
+	for _, button := range k.buttons[a] {
+		names = append(names, buttonName(button))
+	}
+	if len(names) == 0 {
+		return "unbound"
+	}
+	return strings.Join(names, ", ")
+}
+
+// pressed reports whether any button bound to an action is held down
+func (f *frontend) pressed(a action) bool {
+	for _, button := range f.keys.buttons[a] {
+		if f.free(button) && f.win.Pressed(button) {
+			return true
+		}
+	}
+	return false
+}
+
+// justPressed reports whether any button bound to an action was pressed this frame
+func (f *frontend) justPressed(a action) bool {
+	for _, button := range f.keys.buttons[a] {
+		if f.free(button) && f.win.JustPressed(button) {
+			return true
+		}
+	}
+	return false
+}
+
+// free reports whether a button is the game's this frame. Mouse buttons aren't while
+// the mouse is over the interface
+func (f *frontend) free(button pixelgl.Button) bool {
+	return button > pixelgl.MouseButtonLast || !f.ui.CapturedMouse()
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/faiface/pixel"
+	"github.com/faiface/pixel/pixelgl"
+	"github.com/mmogo/mmo/client/ui"
+	"golang.org/x/image/colornames"
+)
+
+// keyScreen lists the bindable actions and the buttons bound to them
+type keyScreen struct {
+	panel     *ui.Panel
+	title     *ui.Label
+	list      *ui.List
+	help      *ui.Label
+	capturing bool // waiting for a key to bind to the selected action
+}
+
+// addKeyBindings adds the key bindings screen, hidden until it is opened. It goes over
+// everything else, so it must be added last
+func (f *frontend) addKeyBindings() {
+	screen := &keyScreen{
+		title: &ui.Label{Text: "Key bindings"},
+		list: &ui.List{
+			Selectable: true,
+			OnActivate: func(int) error {
+				f.keyScreen.capturing = true
+				return nil
+			},
+		},
+		help: &ui.Label{Text: "enter rebind, delete unbind, r default, esc close", Color: colornames.Gray},
+	}
+	screen.panel = &ui.Panel{
+		Box:     ui.Box{Hidden: true},
+		Color:   pixel.RGB(0, 0, 0).Mul(pixel.Alpha(0.8)),
+		Widgets: []ui.Widget{screen.title, screen.list, screen.help},
+	}
+	f.keyScreen = screen
+	f.ui.Add(screen.panel)
+}
+
+// openKeyBindings shows the key bindings screen with the keyboard on its list
+func (f *frontend) openKeyBindings() {
+	f.keyScreen.panel.Hidden = false
+	f.ui.Focus(f.keyScreen.list)
+}
+
+// processKeyBindingsInput drives the key bindings screen. Up and down pick an action, enter
+// or clicking it again rebinds it to the next key or mouse button pressed, delete unbinds it
+// and R restores its default. Escape closes the screen. These keys are fixed so the screen can't be lost
+func (f *frontend) processKeyBindingsInput() error {
+	win, keys, screen := f.win, f.keys, f.keyScreen
+	// the list keeps the keyboard, even after a click beside it
+	f.ui.Focus(screen.list)
+	if screen.list.Selected < 0 {
+		return nil
+	}
+	selected := bindableActions[screen.list.Selected]
+	switch {
+	case win.JustPressed(pixelgl.KeyEscape) || f.justPressed(actKeyBindings):
+		screen.panel.Hidden = true
+		f.ui.Blur()
+	case win.JustPressed(pixelgl.KeyDelete) || win.JustPressed(pixelgl.KeyBackspace):
+		keys.buttons[selected] = nil
+		return keys.save()
+	case win.JustPressed(pixelgl.KeyR):
+		keys.buttons[selected] = defaultKeyBindings[selected]
+		return keys.save()
+	}
+	return nil
+}
+
+// processKeyCapture binds the selected action to the first key or mouse button pressed,
+// ahead of the interface so that the list doesn't act on it too. Escape cancels
+func (f *frontend) processKeyCapture() error {
+	win, keys, screen := f.win, f.keys, f.keyScreen
+	if win.JustPressed(pixelgl.KeyEscape) {
+		screen.capturing = false
+		return nil
+	}
+	for _, button := range buttonNames {
+		if win.JustPressed(button) {
+			keys.buttons[bindableActions[screen.list.Selected]] = []pixelgl.Button{button}
+			screen.capturing = false
+			return keys.save()
+		}
+	}
+	return nil
+}
+
+// layoutKeyBindings lists the bindings and centres the screen in the window
+func (f *frontend) layoutKeyBindings() {
+	screen := f.keyScreen
+	if screen.panel.Hidden {
+		return
+	}
+	rows := make([]ui.Row, len(bindableActions))
+	for i, a := range bindableActions {
+		bound := f.keys.describe(a)
+		if screen.capturing && i == screen.list.Selected {
+			bound = "press a key or mouse button"
+		}
+		rows[i] = ui.Row{Text: fmt.Sprintf("%-18s %s", a, bound)}
+	}
+	screen.list.SetRows(rows)
+
+	lineHeight := f.ui.LineHeight()
+	bounds := f.win.Bounds()
+	// the list scrolls if the window is too short for all of it
+	height := math.Min(float64(len(bindableActions)+3)*lineHeight+20, bounds.H()-20)
+	panel := pixel.R(bounds.W()/2-200, bounds.H()/2-height/2, bounds.W()/2+200, bounds.H()/2+height/2)
+	screen.panel.SetBounds(panel)
+	inner := pixel.R(panel.Min.X+5, panel.Min.Y+5, panel.Max.X-5, panel.Max.Y-5)
+	rest := ui.Column(inner, lineHeight, screen.title)
+	screen.help.SetBounds(pixel.R(rest.Min.X, rest.Min.Y, rest.Max.X, rest.Min.Y+lineHeight))
+	screen.list.SetBounds(pixel.R(rest.Min.X, rest.Min.Y+lineHeight, rest.Max.X, rest.Max.Y))
+}
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"image/color"
+	"math"
+
+	"github.com/faiface/pixel"
+	"github.com/faiface/pixel/imdraw"
+	"github.com/faiface/pixel/pixelgl"
+	"github.com/mmogo/mmo/client/game"
+	"github.com/mmogo/mmo/shared"
+	"golang.org/x/image/colornames"
+)
+
+const (
+	minimapSize    = 160      // pixels wide and high
+	minimapScale   = 1.0 / 16 // of the main view
+	worldMapMargin = 40       // pixels around the world map
+)
+
+// minimap draws the map from above, small in a corner around the local player or
+// filling the window as the world map. Both are drawn with the main view's isometric
+// transform, shrunk, with a dot for each player and NPC
+type minimap struct {
+	terrain *pixel.Sprite // one pixel per tile
+	center  pixel.Vec     // world position of the middle of the terrain sprite
+	size    pixel.Vec     // of the map in world units
+	corner  *pixelgl.Canvas
+	full    *pixelgl.Canvas
+	imd     *imdraw.IMDraw
+}
+
+// newMinimap draws the terrain of a tile map, open tiles green and blocked ones grey
+func newMinimap(tileMap *shared.TileMap) *minimap {
+	w, h := tileMap.Max.X-tileMap.Min.X+1, tileMap.Max.Y-tileMap.Min.Y+1
+	pic := pixel.MakePictureData(pixel.R(0, 0, float64(w), float64(h)))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			c := colornames.Olivedrab
+			if tileMap.Blocked(shared.Tile{X: tileMap.Min.X + x, Y: tileMap.Min.Y + y}) {
+				c = colornames.Dimgray
+			}
+			pic.Pix[pic.Index(pixel.V(float64(x), float64(y)))] = c
+		}
+	}
+	// the sprite is drawn centred, so its middle is halfway between the corner tiles
+	center := shared.TileCenter(tileMap.Min).Add(shared.TileCenter(tileMap.Max)).Scaled(0.5)
+	return &minimap{
+		terrain: pixel.NewSprite(pic, pic.Bounds()),
+		center:  center,
+		size:    pixel.V(float64(w), float64(h)).Scaled(shared.TileSize),
+		corner:  pixelgl.NewCanvas(pixel.R(-minimapSize/2, -minimapSize/2, minimapSize/2, minimapSize/2)),
+		full:    pixelgl.NewCanvas(pixel.R(0, 0, 1, 1)),
+		imd:     imdraw.New(nil),
+	}
+}
+
+// drawMinimap draws the minimap in the bottom right corner of the window, in screen space
+func (f *frontend) drawMinimap(v *game.View) {
+	if f.showWorldMap {
+		return
+	}
+	bounds := f.win.Bounds()
+	area := pixel.R(bounds.W()-minimapSize-10, 40, bounds.W()-10, 40+minimapSize)
+	f.minimap.draw(f.win, f.minimap.corner, v, v.Player().Position, minimapScale, area)
+}
+
+// drawWorldMap draws the whole map over the window, in screen space
+func (f *frontend) drawWorldMap(v *game.View) {
+	if !f.showWorldMap {
+		return
+	}
+	m := f.minimap
+	area := f.win.Bounds()
+	area = pixel.R(area.Min.X+worldMapMargin, area.Min.Y+worldMapMargin, area.Max.X-worldMapMargin, area.Max.Y-worldMapMargin)
+	// on screen the map is a diamond as wide as its width and height together over root two, and half as high
+	isoW := (m.size.X + m.size.Y) / math.Sqrt2
+	scale := math.Min(area.W()/isoW, area.H()/(isoW/2))
+	m.draw(f.win, m.full, v, m.center, scale, area)
+}
+
+// draw shows the map around a world position, at a scale of the main view, in an area of the window
+func (m *minimap) draw(win *pixelgl.Window, canvas *pixelgl.Canvas, v *game.View, center pixel.Vec, scale float64, area pixel.Rect) {
+	size := area.Size()
+	if canvas.Bounds().Size() != size {
+		canvas.SetBounds(pixel.R(-size.X/2, -size.Y/2, size.X/2, size.Y/2))
+	}
+	canvas.Clear(colornames.Black)
+	// the main view's transform, centred on the point and shrunk
+	view := shared.IsoMatrix.Moved(shared.MapToIso(center).Scaled(-1)).Scaled(pixel.ZV, scale)
+	canvas.SetMatrix(view)
+	m.terrain.Draw(canvas, pixel.IM.Scaled(pixel.ZV, shared.TileSize).Moved(m.center))
+
+	// dots are drawn unsquashed, at the projected positions
+	canvas.SetMatrix(pixel.IM)
+	onMap := func(pos pixel.Vec) pixel.Vec {
+		return shared.MapToIso(pos).Sub(shared.MapToIso(center)).Scaled(scale)
+	}
+	imd := m.imd
+	imd.Clear()
+	for _, entity := range v.Entities {
+		if entity.Kind != shared.E_NPC {
+			continue
+		}
+		imd.Color = npcColor(entity.NPC)
+		imd.Push(onMap(entity.Position))
+		imd.Circle(2, 0)
+	}
+	for _, player := range v.Players {
+		var c color.Color = player.Color
+		radius := 3.0
+		if player.ID == v.PlayerID {
+			c, radius = colornames.White, 4
+		}
+		imd.Color = c
+		imd.Push(onMap(player.Position))
+		imd.Circle(radius, 0)
+	}
+	imd.Draw(canvas)
+	canvas.Draw(win, pixel.IM.Moved(area.Center()))
+
+	imd.Clear()
+	imd.Color = colornames.White
+	imd.Push(area.Min, area.Max)
+	imd.Rectangle(1)
+	imd.Draw(win)
+}
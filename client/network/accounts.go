@@ -0,0 +1,71 @@
+package network
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/mmogo/mmo/shared"
+)
+
+// accountClient talks to the account endpoints of game servers
+var accountClient = &http.Client{Timeout: time.Second * 10}
+
+// Register creates an account on a server, given the base URL of its account endpoints
+func Register(accounts, account, password string) error {
+	return post(accounts, shared.RegisterPath, &shared.AccountRequest{Account: account, Password: password}, nil)
+}
+
+// Login logs in to an account on a server, returning a token to connect with
+// and the account's characters
+func Login(accounts, account, password string) (*shared.LoginResponse, error) {
+	res := &shared.LoginResponse{}
+	if err := post(accounts, shared.LoginPath, &shared.AccountRequest{Account: account, Password: password}, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CreateCharacter adds a character to the account a token is for, returning its characters
+func CreateCharacter(accounts, token, name string, appearance *shared.Appearance) (*shared.LoginResponse, error) {
+	res := &shared.LoginResponse{}
+	req := &shared.CreateCharacterRequest{Token: token, Name: name, Appearance: appearance}
+	if err := post(accounts, shared.CreateCharacterPath, req, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// post sends a request to an account endpoint and decodes the response into res, unless it is nil.
+// A refused request's error is the reason the server gave
+func post(accounts, path string, req, res interface{}) error {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+	if strings.HasPrefix(accounts, "http://") {
+		netLog.Warn("sending account details without TLS", "url", accounts)
+	}
+	netLog.Debug("account request", "url", accounts, "path", path)
+	resp, err := accountClient.Post(accounts+path, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(resp.Body)
+		reason := strings.TrimSpace(string(body))
+		if reason == "" {
+			reason = resp.Status
+		}
+		return errors.New(reason)
+	}
+	if res == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(res)
+}
@@ -0,0 +1,171 @@
+// Package network is the client side of the game protocol: connecting to
+// a server, reading its updates and sending requests. It does no rendering,
+// so headless tools such as the load bot can use it as well as the game client
+package network
+
+import (
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/faiface/pixel"
+	"github.com/mmogo/mmo/shared"
+	"github.com/mmogo/mmo/shared/logging"
+	"github.com/xtaci/smux"
+)
+
+// KeepAliveInterval is how often a quiet client must send something to stay connected
+const KeepAliveInterval = time.Second * 2
+
+var netLog = logging.New("net")
+
+// Connect dials the server and joins the game as the player with the given ID, using a token
+// from logging in to their account. The token may be empty on servers open to anyone.
+// Closing the connection returned hangs up on the server
+func Connect(protocol, addr, id, token string) (net.Conn, error) {
+	netLog.Info("connecting", "addr", addr, "protocol", protocol, "id", id)
+	raw, err := shared.Dial(protocol, addr)
+	if err != nil {
+		return nil, err
+	}
+	session, err := smux.Client(raw, smux.DefaultConfig())
+	if err != nil {
+		raw.Close()
+		return nil, err
+	}
+	stream, err := session.OpenStream()
+	if err != nil {
+		session.Close()
+		raw.Close()
+		return nil, err
+	}
+	conn := &sessionConn{Conn: stream, session: session, raw: raw}
+
+	connectionRequest := &shared.ConnectRequest{
+		ID:    id,
+		Token: token,
+	}
+	if err := shared.SendMessage(&shared.Message{
+		Request: &shared.Request{
+			ConnectRequest: connectionRequest,
+		}}, conn); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	netLog.Info("connected", "id", id)
+	return conn, nil
+}
+
+// sessionConn is the stream the game is played over, which closes the session
+// and connection it runs over along with it
+type sessionConn struct {
+	net.Conn
+	session *smux.Session
+	raw     net.Conn
+}
+
+func (c *sessionConn) Close() error {
+	err := c.Conn.Close()
+	c.session.Close()
+	c.raw.Close()
+	return err
+}
+
+// Receive waits for the next message from the server. Errors sent by the server are
+// returned as errors, and pings are answered before the message is returned
+func Receive(conn net.Conn) (*shared.Message, error) {
+	msg, err := shared.GetMessage(conn)
+	if err != nil {
+		return nil, shared.FatalErr(err)
+	}
+	netLog.Debug("received", "msg", msg)
+	if msg.Error != nil {
+		return nil, fmt.Errorf("server returned an error: %v", msg.Error.Message)
+	}
+	if msg.Update != nil && msg.Update.Ping != nil {
+		if err := RequestPong(msg.Update.Ping.Sent, conn); err != nil {
+			return nil, err
+		}
+	}
+	return msg, nil
+}
+
+// SendKeepAlive sends an empty message so the server knows the client is still there
+func SendKeepAlive(conn net.Conn) error {
+	return shared.SendMessage(&shared.Message{}, conn)
+}
+
+func RequestMove(direction pixel.Vec, conn net.Conn) error {
+	msg := &shared.Message{
+		Request: &shared.Request{MoveRequest: &shared.MoveRequest{
+			Direction: direction,
+			Created:   time.Now(),
+		},
+		}}
+	return shared.SendMessage(msg, conn)
+}
+
+func RequestSpeak(txt string, conn net.Conn) error {
+	msg := &shared.Message{
+		Request: &shared.Request{SpeakRequest: &shared.SpeakRequest{
+			Text: txt,
+		}},
+	}
+	return shared.SendMessage(msg, conn)
+}
+
+func RequestShoot(direction pixel.Vec, conn net.Conn) error {
+	msg := &shared.Message{
+		Request: &shared.Request{ShootRequest: &shared.ShootRequest{
+			Direction: direction,
+			Created:   time.Now(),
+		}},
+	}
+	return shared.SendMessage(msg, conn)
+}
+
+func RequestPong(sent time.Time, conn net.Conn) error {
+	msg := &shared.Message{
+		Request: &shared.Request{PongRequest: &shared.PongRequest{
+			Sent: sent,
+		}},
+	}
+	return shared.SendMessage(msg, conn)
+}
+
+func RequestCast(spell string, target pixel.Vec, conn net.Conn) error {
+	msg := &shared.Message{
+		Request: &shared.Request{CastRequest: &shared.CastRequest{
+			Spell:  spell,
+			Target: target,
+		}},
+	}
+	return shared.SendMessage(msg, conn)
+}
+
+func RequestPickup(entityID string, conn net.Conn) error {
+	msg := &shared.Message{
+		Request: &shared.Request{PickupRequest: &shared.PickupRequest{
+			EntityID: entityID,
+		}},
+	}
+	return shared.SendMessage(msg, conn)
+}
+
+func RequestEquip(itemID string, conn net.Conn) error {
+	msg := &shared.Message{
+		Request: &shared.Request{EquipRequest: &shared.EquipRequest{
+			ItemID: itemID,
+		}},
+	}
+	return shared.SendMessage(msg, conn)
+}
+
+func RequestUnequip(slot shared.EquipSlot, conn net.Conn) error {
+	msg := &shared.Message{
+		Request: &shared.Request{UnequipRequest: &shared.UnequipRequest{
+			Slot: slot,
+		}},
+	}
+	return shared.SendMessage(msg, conn)
+}
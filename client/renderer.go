@@ -0,0 +1,267 @@
+package main
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+	"net"
+	"sort"
+
+	"github.com/faiface/pixel"
+	"github.com/faiface/pixel/imdraw"
+	"github.com/faiface/pixel/pixelgl"
+	"github.com/faiface/pixel/text"
+	"github.com/mmogo/mmo/client/game"
+	"github.com/mmogo/mmo/client/ui"
+	"github.com/mmogo/mmo/shared"
+	"golang.org/x/image/colornames"
+	"golang.org/x/image/font/basicfont"
+)
+
+var _ game.Renderer = &frontend{}
+
+// frontend is the game as played in a pixelgl window. It renders views of the world
+// and turns keyboard and mouse input into the local player's actions, or controls a replay
+type frontend struct {
+	world *game.World
+	conn  net.Conn
+	win   *pixelgl.Window
+
+	playerSprite *Sprite
+	arrowSprite  *pixel.Sprite
+	itemSprites  map[string]*pixel.Sprite
+	sheets       map[string]pixel.Picture
+	terrain      *terrain
+	minimap      *minimap
+	playerText   *text.Text
+	hudText      *text.Text
+	imd          *imdraw.IMDraw
+
+	wincenter    pixel.Vec
+	centerMatrix pixel.Matrix
+	camPos       pixel.Vec
+	cam          pixel.Matrix
+	debug        bool
+	replay       *game.Replay // nil unless watching a replay
+	keys         *keyBindings
+
+	ui           *ui.UI
+	chatLog      *ui.List
+	chatInput    *ui.TextInput
+	inventory    *inventoryWindow
+	keyScreen    *keyScreen
+	showWorldMap bool
+}
+
+// newWindow opens the game window
+func newWindow() (*pixelgl.Window, error) {
+	cfg := pixelgl.WindowConfig{
+		Title:  "loading",
+		Bounds: pixel.R(0, 0, 800, 600),
+		VSync:  true,
+	}
+	win, err := pixelgl.NewWindow(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("creating window: %v", err)
+	}
+	return win, nil
+}
+
+// newFrontend loads the assets needed to play in a window
+func newFrontend(win *pixelgl.Window, world *game.World, conn net.Conn, keys *keyBindings) (*frontend, error) {
+	// load assets
+	arrowImage, err := loadPicture("sprites/arrow.png")
+	if err != nil {
+		return nil, err
+	}
+	playerSprite, err := LoadSpriteSheet("sprites/char1.png", nil)
+	if err != nil {
+		return nil, shared.FatalErr(err)
+	}
+	terrain, err := newTerrain(world.TileMap())
+	if err != nil {
+		return nil, err
+	}
+	atlas := text.NewAtlas(basicfont.Face7x13, text.ASCII)
+
+	f := &frontend{
+		world:        world,
+		conn:         conn,
+		keys:         keys,
+		win:          win,
+		playerSprite: playerSprite,
+		arrowSprite:  pixel.NewSprite(arrowImage, arrowImage.Bounds()),
+		itemSprites:  make(map[string]*pixel.Sprite),
+		sheets:       make(map[string]pixel.Picture),
+		terrain:      terrain,
+		minimap:      newMinimap(world.TileMap()),
+		playerText:   text.New(pixel.ZV, atlas),
+		hudText:      text.New(pixel.ZV, atlas),
+		imd:          imdraw.New(nil),
+		wincenter:    win.Bounds().Center(),
+		ui:           ui.New(win, atlas),
+	}
+	f.centerMatrix = pixel.IM.Moved(f.wincenter)
+	f.addChat()
+	f.addInventory()
+	f.addKeyBindings()
+	return f, nil
+}
+
+// Render draws a view of the world to the window
+func (f *frontend) Render(v *game.View, dt float64) error {
+	win := f.win
+	win.Clear(colornames.Yellow)
+
+	f.playerSprite.Animate(dt, v.Facing, v.Action)
+
+	pos := shared.MapToIso(v.Player().Position)
+	f.camPos = pixel.Lerp(f.camPos, f.wincenter.Sub(pos), 1-math.Pow(1.0/128, dt))
+	f.cam = pixel.IM.Moved(f.camPos)
+	win.SetMatrix(f.cam)
+	f.terrain.draw(win, win.Bounds().Moved(f.camPos.Scaled(-1)), f.debug)
+	if err := f.drawWorld(v); err != nil {
+		return err
+	}
+	f.drawSpellEffects(v)
+	f.drawPath(v)
+	for _, player := range v.Players {
+		f.drawPlayerText(v, player)
+	}
+
+	// show the tile under the mouse
+	playerText := f.playerText
+	mousePos := f.cam.Unproject(win.MousePosition())
+	playerText.Clear()
+	playerText.Dot = playerText.Orig
+	tile := shared.TileAt(shared.IsoToMap(mousePos))
+	playerText.WriteString(fmt.Sprintf("(%v, %v)", tile.X, tile.Y))
+	playerText.DrawColorMask(win, pixel.IM.Moved(mousePos), colornames.White)
+
+	win.SetMatrix(pixel.IM)
+	f.drawHUD(v)
+	f.drawMinimap(v)
+	if f.replay != nil {
+		f.drawReplayBar()
+	}
+	f.drawWorldMap(v)
+	f.layoutChat(v)
+	f.layoutInventory(v)
+	f.layoutKeyBindings()
+	f.ui.Draw()
+	win.SetMatrix(f.cam)
+
+	win.Update()
+	return nil
+}
+
+// sprite is something to draw in the world at a depth
+type sprite struct {
+	depth float64
+	id    string
+	draw  func() error
+}
+
+// drawWorld draws entities and players from the back of the screen to the front, so that
+// nearer ones overlap those behind them, in screen space
+func (f *frontend) drawWorld(v *game.View) error {
+	win := f.win
+	sprites := make([]sprite, 0, len(v.Entities)+len(v.Players))
+	for _, entity := range v.Entities {
+		entity := entity
+		pos := shared.MapToIso(entity.Position)
+		var draw func() error
+		switch entity.Kind {
+		case shared.E_ITEM:
+			draw = func() error {
+				itemSprite, err := f.itemSprite(entity.Item.ItemID)
+				if err != nil {
+					return err
+				}
+				itemSprite.Draw(win, pixel.IM.Scaled(pixel.ZV, 2.0).Moved(pos))
+				return nil
+			}
+		case shared.E_NPC:
+			draw = func() error {
+				f.playerSprite.Animate(0, entity.Facing, entity.Action)
+				f.playerSprite.Draw(win, pixel.IM.Moved(pos), npcColor(entity.NPC))
+				return nil
+			}
+		case shared.E_PROJECTILE:
+			draw = func() error {
+				angle := shared.MapToIso(entity.Projectile.Velocity).Angle()
+				f.arrowSprite.Draw(win, pixel.IM.Rotated(pixel.ZV, angle).Moved(pos))
+				return nil
+			}
+		default:
+			continue
+		}
+		sprites = append(sprites, sprite{depth: shared.Depth(entity.Position), id: entity.ID, draw: draw})
+	}
+	for _, player := range v.Players {
+		player := player
+		sprites = append(sprites, sprite{
+			depth: shared.Depth(player.Position),
+			id:    player.ID,
+			draw:  func() error { return f.drawPlayer(v, player) },
+		})
+	}
+	// ties are broken by ID so that overlapping sprites don't flicker
+	sort.Slice(sprites, func(i, j int) bool {
+		if sprites[i].depth != sprites[j].depth {
+			return sprites[i].depth > sprites[j].depth
+		}
+		return sprites[i].id < sprites[j].id
+	})
+	for _, s := range sprites {
+		if err := s.draw(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// drawPlayer draws a player with their equipment, in screen space
+func (f *frontend) drawPlayer(v *game.View, player *shared.ClientPlayer) error {
+	playerPos := pixel.IM.Moved(shared.MapToIso(player.Position))
+	facing, action := v.Animation(player)
+	f.playerSprite.Animate(0, facing, action)
+	layers, err := f.equipmentLayers(player.Equipment)
+	if err != nil {
+		return err
+	}
+	f.playerSprite.DrawLayers(f.win, playerPos, player.Color, layers)
+	return nil
+}
+
+// drawPlayerText draws a player's level and speech over everything in the world, in screen space
+func (f *frontend) drawPlayerText(v *game.View, player *shared.ClientPlayer) {
+	win, playerText := f.win, f.playerText
+	pos := shared.MapToIso(player.Position)
+	if player.Level > 0 && v.PlayerID != player.ID {
+		label := fmt.Sprintf("Lv %v", player.Level)
+		playerText.Clear()
+		playerText.Dot = playerText.Orig
+		playerText.Dot.X -= playerText.BoundsOf(label).W() / 2
+		playerText.WriteString(label)
+		playerText.DrawColorMask(win, pixel.IM.Moved(pixel.V(pos.X, pos.Y-48)), player.Color)
+	}
+	txt := v.Speech[player.ID]
+	for i, line := range txt {
+		playerText.Clear()
+		playerText.Dot = playerText.Orig
+		playerText.Dot.X -= playerText.BoundsOf(line).W() / 2
+		playerText.Dot.Y += playerText.BoundsOf(line).H() * float64(len(txt)-i)
+		playerText.WriteString(line + "\n")
+		playerText.DrawColorMask(win,
+			pixel.IM.Scaled(pixel.ZV, 2).Moved(pixel.V(pos.X, pos.Y+20)),
+			player.Color)
+	}
+}
+
+func npcColor(npc *shared.NPC) color.Color {
+	if c, ok := colornames.Map[npc.Color]; ok {
+		return c
+	}
+	return colornames.White
+}
@@ -0,0 +1,145 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/faiface/pixel"
+	"github.com/faiface/pixel/pixelgl"
+	"github.com/mmogo/mmo/client/game"
+	"golang.org/x/image/colornames"
+)
+
+const (
+	replaySeekStep = time.Second * 5
+	replayMinSpeed = 0.25
+	replayMaxSpeed = 16
+)
+
+// runReplay plays a recording in the game window until it is closed
+func runReplay(path string, keys *keyBindings) error {
+	replay, err := game.LoadReplay(path)
+	if err != nil {
+		return err
+	}
+	win, err := newWindow()
+	if err != nil {
+		return err
+	}
+	f, err := newFrontend(win, replay.World(), nil, keys)
+	if err != nil {
+		return err
+	}
+	f.replay = replay
+
+	fps := 0 // calculated frames per second
+	second := time.Tick(time.Second)
+	last := time.Now()
+	for !f.win.Closed() {
+		dt := time.Since(last).Seconds()
+		last = time.Now()
+
+		if err := f.processReplayInput(); err != nil {
+			return err
+		}
+		replay.Advance(dt)
+		// seeking backwards rebuilds the world
+		f.world = replay.World()
+		if err := f.Render(f.world.View(), dt); err != nil {
+			return err
+		}
+
+		fps++
+		select {
+		default:
+		case <-second:
+			f.win.SetTitle(fmt.Sprintf("replay of %s, %v fps", replay.Started().Format(time.RFC1123), fps))
+			fps = 0
+		}
+	}
+	return nil
+}
+
+// replayBar is where the seek bar is drawn, in screen space
+func (f *frontend) replayBar() pixel.Rect {
+	bounds := f.win.Bounds()
+	return pixel.R(20, 10, bounds.W()-20, 18)
+}
+
+// processReplayInput controls playback: space pauses, left and right seek,
+// up and down change speed, home restarts and clicking the bar seeks to that point
+func (f *frontend) processReplayInput() error {
+	win, replay := f.win, f.replay
+	if err := f.ui.Update(); err != nil {
+		return err
+	}
+	if f.justPressed(actDebug) {
+		f.debug = !f.debug
+	}
+	if f.justPressed(actWorldMap) {
+		f.showWorldMap = !f.showWorldMap
+	}
+	f.processChatScroll()
+	if win.JustPressed(pixelgl.KeySpace) {
+		replay.SetPaused(!replay.Paused())
+	}
+	if win.JustPressed(pixelgl.KeyLeft) {
+		replay.Seek(replay.Position() - replaySeekStep)
+	}
+	if win.JustPressed(pixelgl.KeyRight) {
+		replay.Seek(replay.Position() + replaySeekStep)
+	}
+	if win.JustPressed(pixelgl.KeyHome) {
+		replay.Seek(0)
+	}
+	if win.JustPressed(pixelgl.KeyUp) && replay.Speed() < replayMaxSpeed {
+		replay.SetSpeed(replay.Speed() * 2)
+	}
+	if win.JustPressed(pixelgl.KeyDown) && replay.Speed() > replayMinSpeed {
+		replay.SetSpeed(replay.Speed() / 2)
+	}
+	// the bar is thin, so clicks just off it count too
+	bar := f.replayBar()
+	target := pixel.R(bar.Min.X, bar.Min.Y-8, bar.Max.X, bar.Max.Y+8)
+	mouse := win.MousePosition()
+	if win.JustPressed(pixelgl.MouseButtonLeft) && target.Contains(mouse) {
+		fraction := (mouse.X - bar.Min.X) / bar.W()
+		replay.Seek(time.Duration(fraction * float64(replay.Length())))
+	}
+	return nil
+}
+
+// drawReplayBar draws the playback position and controls in screen space
+func (f *frontend) drawReplayBar() {
+	win, txt, imd, replay := f.win, f.hudText, f.imd, f.replay
+	bar := f.replayBar()
+	played := 1.0
+	if length := replay.Length(); length > 0 {
+		played = float64(replay.Position()) / float64(length)
+	}
+
+	imd.Clear()
+	imd.Color = colornames.Black
+	imd.Push(bar.Min, bar.Max)
+	imd.Rectangle(0)
+	imd.Color = colornames.Steelblue
+	imd.Push(bar.Min, pixel.V(bar.Min.X+bar.W()*played, bar.Max.Y))
+	imd.Rectangle(0)
+	imd.Draw(win)
+
+	state := "playing"
+	if replay.Paused() {
+		state = "paused"
+	}
+	txt.Clear()
+	txt.Dot = txt.Orig
+	fmt.Fprintf(txt, "%s %s / %s  x%v  (space pause, left/right seek, up/down speed, home restart)",
+		state, formatReplayTime(replay.Position()), formatReplayTime(replay.Length()), replay.Speed())
+	txt.DrawColorMask(win, pixel.IM.Moved(pixel.V(bar.Min.X, bar.Max.Y+6)), colornames.White)
+}
+
+// formatReplayTime formats a point in a recording as minutes and seconds
+func formatReplayTime(d time.Duration) string {
+	seconds := int(d / time.Second)
+	return fmt.Sprintf("%d:%02d", seconds/60, seconds%60)
+}
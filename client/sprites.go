@@ -29,6 +29,8 @@ type Sprite struct {
 	Frame   int     // current frame
 	Speed   float64 // frames per second
 	elapsed float64
+	frame   pixel.Rect
+	layer   *pixel.Sprite
 }
 
 func (s *Sprite) Animate(dt float64, facing shared.Direction, action shared.Action) {
@@ -43,7 +45,8 @@ func (s *Sprite) Animate(dt float64, facing shared.Direction, action shared.Acti
 	if len(s.Frames[facing][action]) > 0 {
 		s.Frame = int(s.elapsed/s.Speed) % len(s.Frames[facing][action])
 	}
-	s.Sprite.Set(s.Picture, s.Frames[facing][action][s.Frame])
+	s.frame = s.Frames[facing][action][s.Frame]
+	s.Sprite.Set(s.Picture, s.frame)
 
 }
 
@@ -54,6 +57,19 @@ func (s *Sprite) Draw(target pixel.Target, matrix pixel.Matrix, color color.Colo
 	s.Sprite.DrawColorMask(target, matrix, color)
 }
 
+// DrawLayers draws the sprite tinted by color, then the current frame of each layer sheet over it.
+// Layer sheets must share the sprite's frame layout, as sheets from the LPC generator do
+func (s *Sprite) DrawLayers(target pixel.Target, matrix pixel.Matrix, color color.Color, layers []pixel.Picture) {
+	s.Draw(target, matrix, color)
+	if s.layer == nil {
+		s.layer = pixel.NewSprite(nil, pixel.Rect{})
+	}
+	for _, layer := range layers {
+		s.layer.Set(layer, s.frame)
+		s.layer.Draw(target, matrix)
+	}
+}
+
 func atlasL() Atlas {
 	m := map[shared.Direction]map[shared.Action][]pixel.Rect{}
 	for _, dir := range []shared.Direction{UP, DOWN, LEFT, RIGHT, UPLEFT, UPRIGHT, DOWNLEFT, DOWNRIGHT} {
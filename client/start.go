@@ -0,0 +1,457 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"image/color"
+	"io/ioutil"
+	"net"
+	"os"
+	"time"
+
+	"github.com/faiface/pixel"
+	"github.com/faiface/pixel/pixelgl"
+	"github.com/faiface/pixel/text"
+	"github.com/mmogo/mmo/client/network"
+	"github.com/mmogo/mmo/client/ui"
+	"github.com/mmogo/mmo/shared"
+	"golang.org/x/image/colornames"
+	"golang.org/x/image/font/basicfont"
+)
+
+const (
+	startPanelWidth = 360
+	startListRows   = 8
+	startButtonH    = 24 // height of buttons and text inputs
+	startGap        = 8
+	previewWidth    = 100 // of the box the new character is shown in
+)
+
+// loadServerList reads the servers to choose from, a JSON list as served to the patcher.
+// Without the file, the only server is the one at addr, with its accounts at the URL given
+func loadServerList(path, addr, accounts string) ([]shared.ServerInfo, error) {
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return []shared.ServerInfo{{Name: addr, Addr: addr, Accounts: accounts}}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var servers []shared.ServerInfo
+	if err := json.Unmarshal(data, &servers); err != nil {
+		return nil, fmt.Errorf("parsing %s: %v", path, err)
+	}
+	if len(servers) == 0 {
+		return nil, fmt.Errorf("%s lists no servers", path)
+	}
+	return servers, nil
+}
+
+// startScreens are shown before playing: choosing a server, logging in to an account on it,
+// then choosing or creating a character and connecting as them. One screen is shown at a time
+type startScreens struct {
+	win      *pixelgl.Window
+	ui       *ui.UI
+	protocol string
+	servers  []shared.ServerInfo
+	server   shared.ServerInfo     // chosen
+	login    *shared.LoginResponse // nil until logged in
+	color    int                   // index into shared.CharacterColors of the new character's tint
+	preview  *Sprite
+
+	busy    bool              // waiting for the server
+	results chan func() error // finish requests to the server on the main loop
+	conn    net.Conn          // set once connected
+	id      string
+
+	serverPanel *ui.Panel
+	serverTitle *ui.Label
+	serverList  *ui.List
+	serverNext  *ui.Button
+
+	loginPanel    *ui.Panel
+	loginTitle    *ui.Label
+	account       *ui.TextInput
+	password      *ui.TextInput
+	loginButton   *ui.Button
+	registerBtn   *ui.Button
+	loginBack     *ui.Button
+	charPanel     *ui.Panel
+	charTitle     *ui.Label
+	charList      *ui.List
+	playButton    *ui.Button
+	charBack      *ui.Button
+	newCharTitle  *ui.Label
+	name          *ui.TextInput
+	prevColor     *ui.Button
+	colorName     *ui.Label
+	nextColor     *ui.Button
+	createButton  *ui.Button
+	previewBounds pixel.Rect
+
+	status *ui.Label
+}
+
+// chooseCharacter shows the start screens in a window until a character is connected as,
+// returning the connection and the character's ID. The connection is nil if the window was closed.
+// If the server list couldn't be updated, stale says why
+func chooseCharacter(win *pixelgl.Window, protocol string, servers []shared.ServerInfo, stale string) (net.Conn, string, error) {
+	preview, err := LoadSpriteSheet("sprites/char1.png", nil)
+	if err != nil {
+		return nil, "", err
+	}
+	win.SetTitle("mmo")
+	s := &startScreens{
+		win:      win,
+		ui:       ui.New(win, text.NewAtlas(basicfont.Face7x13, text.ASCII)),
+		protocol: protocol,
+		servers:  servers,
+		preview:  preview,
+		results:  make(chan func() error, 1),
+	}
+	s.build()
+	s.showServers()
+	if stale != "" {
+		s.setStatus("the server list may be out of date: "+stale, colornames.Orange)
+	}
+
+	last := time.Now()
+	for !win.Closed() && s.conn == nil {
+		dt := time.Since(last).Seconds()
+		last = time.Now()
+
+		select {
+		case finish := <-s.results:
+			if err := finish(); err != nil {
+				return nil, "", err
+			}
+		default:
+		}
+		if err := s.ui.Update(); err != nil {
+			return nil, "", err
+		}
+		s.preview.Animate(dt, shared.DOWN, shared.A_WALK)
+
+		win.Clear(colornames.Black)
+		s.layout()
+		s.ui.Draw()
+		if !s.charPanel.Hidden {
+			s.preview.Draw(win, pixel.IM.Moved(s.previewBounds.Center()), s.newColor())
+		}
+		win.Update()
+	}
+	return s.conn, s.id, nil
+}
+
+// build makes the widgets of every screen
+func (s *startScreens) build() {
+	s.serverTitle = &ui.Label{Text: "Choose a server", Align: ui.AlignCenter}
+	s.serverList = &ui.List{Selectable: true, Color: s.ui.Theme.Input, OnActivate: s.chooseServer}
+	s.serverNext = &ui.Button{Text: "Continue", Align: ui.AlignCenter, OnClick: func() error {
+		return s.chooseServer(s.serverList.Selected)
+	}}
+	s.serverPanel = &ui.Panel{Widgets: []ui.Widget{s.serverTitle, s.serverList, s.serverNext}}
+	rows := []ui.Row{}
+	for _, server := range s.servers {
+		rows = append(rows, ui.Row{Text: fmt.Sprintf("%-16s %s", server.Name, server.Addr)})
+	}
+	s.serverList.SetRows(rows)
+
+	s.loginTitle = &ui.Label{Align: ui.AlignCenter}
+	s.account = &ui.TextInput{Placeholder: "account", Max: 16, OnSubmit: func(string) error {
+		s.ui.Focus(s.password)
+		return nil
+	}}
+	s.password = &ui.TextInput{Placeholder: "password", Max: 64, Secret: true, OnSubmit: func(string) error {
+		s.logIn(false)
+		return nil
+	}}
+	s.loginButton = &ui.Button{Text: "Log in", Align: ui.AlignCenter, OnClick: func() error {
+		s.logIn(false)
+		return nil
+	}}
+	s.registerBtn = &ui.Button{Text: "Create account", Align: ui.AlignCenter, OnClick: func() error {
+		s.logIn(true)
+		return nil
+	}}
+	s.loginBack = &ui.Button{Text: "Back", Align: ui.AlignCenter, OnClick: func() error {
+		s.showServers()
+		return nil
+	}}
+	s.loginPanel = &ui.Panel{Widgets: []ui.Widget{
+		s.loginTitle, s.account, s.password, s.loginButton, s.registerBtn, s.loginBack,
+	}}
+
+	s.charTitle = &ui.Label{Align: ui.AlignCenter}
+	s.charList = &ui.List{Selectable: true, Color: s.ui.Theme.Input, OnActivate: s.play}
+	s.playButton = &ui.Button{Text: "Play", Align: ui.AlignCenter, OnClick: func() error {
+		return s.play(s.charList.Selected)
+	}}
+	s.charBack = &ui.Button{Text: "Back", Align: ui.AlignCenter, OnClick: func() error {
+		s.showLogin()
+		return nil
+	}}
+	s.newCharTitle = &ui.Label{Text: "New character"}
+	s.name = &ui.TextInput{Placeholder: "name", Max: 16, OnSubmit: func(string) error {
+		s.createCharacter()
+		return nil
+	}}
+	s.prevColor = &ui.Button{Text: "<", Align: ui.AlignCenter, OnClick: func() error {
+		s.color = (s.color + len(shared.CharacterColors) - 1) % len(shared.CharacterColors)
+		return nil
+	}}
+	s.colorName = &ui.Label{Align: ui.AlignCenter}
+	s.nextColor = &ui.Button{Text: ">", Align: ui.AlignCenter, OnClick: func() error {
+		s.color = (s.color + 1) % len(shared.CharacterColors)
+		return nil
+	}}
+	s.createButton = &ui.Button{Text: "Create", Align: ui.AlignCenter, OnClick: func() error {
+		s.createCharacter()
+		return nil
+	}}
+	s.charPanel = &ui.Panel{Widgets: []ui.Widget{
+		s.charTitle, s.charList, s.playButton, s.charBack,
+		s.newCharTitle, s.name, s.prevColor, s.colorName, s.nextColor, s.createButton,
+	}}
+
+	s.status = &ui.Label{Align: ui.AlignCenter}
+	s.ui.Add(s.serverPanel, s.loginPanel, s.charPanel, s.status)
+}
+
+// show shows one screen, hiding the others, with the keyboard on one of its widgets
+func (s *startScreens) show(panel *ui.Panel, focus ui.Widget) {
+	for _, p := range []*ui.Panel{s.serverPanel, s.loginPanel, s.charPanel} {
+		p.Hidden = p != panel
+	}
+	s.setStatus("", nil)
+	s.ui.Focus(focus)
+}
+
+func (s *startScreens) showServers() {
+	if s.serverList.Selected < 0 {
+		s.serverList.Selected = 0
+	}
+	s.show(s.serverPanel, s.serverList)
+}
+
+func (s *startScreens) showLogin() {
+	s.login = nil
+	s.password.SetText("")
+	s.loginTitle.Text = "Log in to " + s.server.Name
+	if s.account.Text == "" {
+		s.show(s.loginPanel, s.account)
+	} else {
+		s.show(s.loginPanel, s.password)
+	}
+}
+
+func (s *startScreens) showCharacters() {
+	s.charTitle.Text = "Characters on " + s.server.Name
+	s.setCharacters(s.login.Characters)
+	if len(s.login.Characters) == 0 {
+		s.show(s.charPanel, s.name)
+	} else {
+		s.show(s.charPanel, s.charList)
+	}
+}
+
+// setCharacters lists an account's characters, each in their own colour
+func (s *startScreens) setCharacters(characters []*shared.Character) {
+	rows := []ui.Row{}
+	for _, c := range characters {
+		rows = append(rows, ui.Row{Text: fmt.Sprintf("%-16s level %v", c.ID, c.Level), Color: appearanceColor(c.Appearance)})
+	}
+	s.charList.SetRows(rows)
+	if s.charList.Selected < 0 || s.charList.Selected >= len(rows) {
+		s.charList.Selected = 0
+	}
+}
+
+func (s *startScreens) setStatus(msg string, c color.Color) {
+	s.status.Text = msg
+	s.status.Color = c
+}
+
+// request calls the server away from the main loop, showing what is being done meanwhile.
+// If the call succeeds, done is run on the main loop, otherwise the error is shown
+func (s *startScreens) request(doing string, call func() error, done func() error) {
+	if s.busy {
+		return
+	}
+	s.busy = true
+	s.setStatus(doing+"...", colornames.Gray)
+	go func() {
+		err := call()
+		s.results <- func() error {
+			s.busy = false
+			if err != nil {
+				clientLog.Warn(doing+" failed", "server", s.server.Addr, "err", err)
+				s.setStatus(err.Error(), colornames.Red)
+				return nil
+			}
+			s.setStatus("", nil)
+			return done()
+		}
+	}()
+}
+
+// chooseServer moves on to logging in to a server
+func (s *startScreens) chooseServer(i int) error {
+	if i < 0 || i >= len(s.servers) {
+		return nil
+	}
+	s.server = s.servers[i]
+	s.showLogin()
+	return nil
+}
+
+// logIn logs in to the account typed, creating it first if asked
+func (s *startScreens) logIn(create bool) {
+	account, password := s.account.Text, s.password.Text
+	if account == "" || password == "" {
+		s.setStatus("enter an account name and password", colornames.Red)
+		return
+	}
+	accounts := s.server.AccountsURL()
+	var res *shared.LoginResponse
+	doing := "Logging in"
+	if create {
+		doing = "Creating account"
+	}
+	s.request(doing, func() error {
+		if create {
+			if err := network.Register(accounts, account, password); err != nil {
+				return err
+			}
+		}
+		var err error
+		res, err = network.Login(accounts, account, password)
+		return err
+	}, func() error {
+		s.login = res
+		s.showCharacters()
+		return nil
+	})
+}
+
+// createCharacter creates a character with the name typed and the colour chosen
+func (s *startScreens) createCharacter() {
+	name := s.name.Text
+	if err := shared.ValidName(name); err != nil {
+		s.setStatus(err.Error(), colornames.Red)
+		return
+	}
+	accounts, token := s.server.AccountsURL(), s.login.Token
+	appearance := &shared.Appearance{Color: shared.CharacterColors[s.color]}
+	var res *shared.LoginResponse
+	s.request("Creating character", func() error {
+		var err error
+		res, err = network.CreateCharacter(accounts, token, name, appearance)
+		return err
+	}, func() error {
+		s.login = res
+		s.name.SetText("")
+		s.setCharacters(res.Characters)
+		s.charList.Selected = len(res.Characters) - 1
+		s.charList.ScrollTo(s.charList.Selected)
+		s.ui.Focus(s.charList)
+		return nil
+	})
+}
+
+// play connects to the server as a character
+func (s *startScreens) play(i int) error {
+	if i < 0 || i >= len(s.login.Characters) {
+		return nil
+	}
+	addr, token, id := s.server.Addr, s.login.Token, s.login.Characters[i].ID
+	var conn net.Conn
+	s.request("Connecting", func() error {
+		var err error
+		conn, err = network.Connect(s.protocol, addr, id, token)
+		return err
+	}, func() error {
+		s.conn, s.id = conn, id
+		return nil
+	})
+	return nil
+}
+
+// newColor is the tint chosen for a new character
+func (s *startScreens) newColor() color.Color {
+	return appearanceColor(&shared.Appearance{Color: shared.CharacterColors[s.color]})
+}
+
+// layout centres the screen shown in the window, with the status line below it
+func (s *startScreens) layout() {
+	lineHeight := s.ui.LineHeight()
+	bounds := s.win.Bounds()
+	busy := s.busy
+	for _, b := range []*ui.Button{s.serverNext, s.loginButton, s.registerBtn, s.loginBack,
+		s.playButton, s.charBack, s.prevColor, s.nextColor, s.createButton} {
+		b.Disabled = busy
+	}
+	s.colorName.Text = shared.CharacterColors[s.color]
+	s.colorName.Color = s.newColor()
+
+	var panel *ui.Panel
+	var height float64
+	listHeight := startListRows*lineHeight + 10
+	switch {
+	case !s.serverPanel.Hidden:
+		panel = s.serverPanel
+		height = lineHeight + listHeight + startButtonH + 4*startGap
+	case !s.loginPanel.Hidden:
+		panel = s.loginPanel
+		height = lineHeight + 3*startButtonH + 5*startGap
+	default:
+		panel = s.charPanel
+		height = 2*lineHeight + listHeight + 4*startButtonH + 8*startGap
+	}
+	r := pixel.R(bounds.W()/2-startPanelWidth/2, bounds.H()/2-height/2, bounds.W()/2+startPanelWidth/2, bounds.H()/2+height/2)
+	panel.SetBounds(r)
+	s.status.SetBounds(pixel.R(0, r.Min.Y-startGap-lineHeight, bounds.W(), r.Min.Y-startGap))
+
+	rest := pixel.R(r.Min.X+startGap, r.Min.Y+startGap, r.Max.X-startGap, r.Max.Y-startGap)
+	var row pixel.Rect
+	// take lays out the next strip of the panel, leaving a gap below it
+	take := func(height float64, widgets ...ui.Widget) pixel.Rect {
+		row, rest = ui.Take(rest, height)
+		ui.Split(row, startGap, widgets...)
+		_, rest = ui.Take(rest, startGap)
+		return row
+	}
+	switch panel {
+	case s.serverPanel:
+		take(lineHeight, s.serverTitle)
+		take(listHeight, s.serverList)
+		take(startButtonH, s.serverNext)
+	case s.loginPanel:
+		take(lineHeight, s.loginTitle)
+		take(startButtonH, s.account, s.password)
+		take(startButtonH, s.loginButton, s.registerBtn)
+		take(startButtonH, s.loginBack)
+	case s.charPanel:
+		take(lineHeight, s.charTitle)
+		take(listHeight, s.charList)
+		take(startButtonH, s.playButton, s.charBack)
+		// the new character is shown beside the choices for it
+		newChar := take(lineHeight + 3*startButtonH + 3*startGap)
+		s.previewBounds = pixel.R(newChar.Max.X-previewWidth, newChar.Min.Y, newChar.Max.X, newChar.Max.Y)
+		rest = pixel.R(newChar.Min.X, newChar.Min.Y, newChar.Max.X-previewWidth-startGap, newChar.Max.Y)
+		take(lineHeight, s.newCharTitle)
+		take(startButtonH, s.name)
+		take(startButtonH, s.prevColor, s.colorName, s.nextColor)
+		take(startButtonH, s.createButton)
+	}
+}
+
+// appearanceColor is the tint of a character's appearance, white if it has none
+func appearanceColor(a *shared.Appearance) color.Color {
+	if a != nil {
+		if c, ok := colornames.Map[a.Color]; ok {
+			return c
+		}
+	}
+	return colornames.White
+}
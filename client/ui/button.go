@@ -0,0 +1,36 @@
+package ui
+
+// Button does something when clicked. One without OnClick is just a line of text
+type Button struct {
+	Box
+	Text     string
+	Align    Align
+	Flat     bool // drawn without a background until the mouse is over it, for rows of a panel
+	Disabled bool // drawn dimmed and not clickable
+	OnClick  func() error
+}
+
+func (b *Button) clickable() bool {
+	return !b.Disabled && b.OnClick != nil
+}
+
+func (b *Button) Update(u *UI) error {
+	if !b.clickable() || !u.Clicked(b) {
+		return nil
+	}
+	return b.OnClick()
+}
+
+func (b *Button) Draw(u *UI) {
+	switch {
+	case b.clickable() && u.Hovered(b):
+		u.Fill(b.Rect, u.Theme.Hover)
+	case !b.Flat:
+		u.Fill(b.Rect, u.Theme.Button)
+	}
+	c := u.Theme.Text
+	if b.Disabled {
+		c = u.Theme.Dim
+	}
+	u.TextIn(b.Text, b.Rect, b.Align, c)
+}
@@ -0,0 +1,151 @@
+package ui
+
+import (
+	"strings"
+
+	"github.com/faiface/pixel"
+	"github.com/faiface/pixel/pixelgl"
+)
+
+// TextInput is a line of editable text. While focused it takes typing, backspace and
+// delete, moves its cursor with the arrow keys, home and end, submits with enter and
+// cancels with escape, which also gives up the keyboard. Clicking it puts the cursor there
+type TextInput struct {
+	Box
+	Text        string
+	Cursor      int // in runes from the start of the text
+	Max         int // runes allowed, or 0 for no limit
+	Placeholder string
+	Secret      bool // drawn as stars, for passwords
+	OnSubmit    func(text string) error
+	OnCancel    func() error
+
+	offset int // first rune shown, scrolled to keep the cursor in view
+}
+
+// Focusable reports that text inputs take the keyboard
+func (t *TextInput) Focusable() bool {
+	return true
+}
+
+// SetText replaces the text and puts the cursor at its end
+func (t *TextInput) SetText(s string) {
+	t.Text = s
+	t.Cursor = len([]rune(s))
+	t.offset = 0
+}
+
+func (t *TextInput) Update(u *UI) error {
+	runes := []rune(t.Text)
+	t.Cursor = clamp(t.Cursor, 0, len(runes))
+	if u.Clicked(t) {
+		t.Cursor = t.runeAt(u, u.Mouse().X)
+	}
+	if !u.Focused(t) {
+		return nil
+	}
+	for _, r := range u.Typed() {
+		if t.Max > 0 && len(runes) >= t.Max {
+			break
+		}
+		runes = append(runes[:t.Cursor], append([]rune{r}, runes[t.Cursor:]...)...)
+		t.Cursor++
+	}
+	switch {
+	case u.Repeated(pixelgl.KeyBackspace) && t.Cursor > 0:
+		runes = append(runes[:t.Cursor-1], runes[t.Cursor:]...)
+		t.Cursor--
+	case u.Repeated(pixelgl.KeyDelete) && t.Cursor < len(runes):
+		runes = append(runes[:t.Cursor], runes[t.Cursor+1:]...)
+	case u.Repeated(pixelgl.KeyLeft) && t.Cursor > 0:
+		t.Cursor--
+	case u.Repeated(pixelgl.KeyRight) && t.Cursor < len(runes):
+		t.Cursor++
+	case u.JustPressed(pixelgl.KeyHome):
+		t.Cursor = 0
+	case u.JustPressed(pixelgl.KeyEnd):
+		t.Cursor = len(runes)
+	}
+	t.Text = string(runes)
+	switch {
+	case u.JustPressed(pixelgl.KeyEnter) && t.OnSubmit != nil:
+		return t.OnSubmit(t.Text)
+	case u.JustPressed(pixelgl.KeyEscape):
+		u.Blur()
+		if t.OnCancel != nil {
+			return t.OnCancel()
+		}
+	}
+	return nil
+}
+
+func (t *TextInput) Draw(u *UI) {
+	u.Fill(t.Rect, u.Theme.Input)
+	focused := u.Focused(t)
+	if focused {
+		u.Outline(t.Rect, u.Theme.Focus)
+	}
+	t.Cursor = clamp(t.Cursor, 0, len([]rune(t.Text)))
+	baseline := u.Baseline(t.Rect)
+	left := t.Rect.Min.X + padding
+	if t.Text == "" {
+		if !focused {
+			u.Text(t.Placeholder, pixel.V(left, baseline), u.Theme.Dim)
+		}
+		t.offset = 0
+	} else {
+		u.Text(t.visible(u), pixel.V(left, baseline), u.Theme.Text)
+	}
+	if focused {
+		x := left + u.TextWidth(string([]rune(t.shown())[t.offset:t.Cursor]))
+		u.Fill(pixel.R(x, baseline-u.LineHeight()/4, x+1, baseline+u.LineHeight()*3/4), u.Theme.Text)
+	}
+}
+
+// shown is the text as drawn, starred out if secret
+func (t *TextInput) shown() string {
+	if t.Secret {
+		return strings.Repeat("*", len([]rune(t.Text)))
+	}
+	return t.Text
+}
+
+// visible scrolls the text to keep the cursor in the box, returning the part which fits
+func (t *TextInput) visible(u *UI) string {
+	runes := []rune(t.shown())
+	width := t.Rect.W() - 2*padding
+	t.offset = clamp(t.offset, 0, t.Cursor)
+	for t.offset < t.Cursor && u.TextWidth(string(runes[t.offset:t.Cursor])) > width {
+		t.offset++
+	}
+	end := len(runes)
+	for end > t.Cursor && u.TextWidth(string(runes[t.offset:end])) > width {
+		end--
+	}
+	return string(runes[t.offset:end])
+}
+
+// runeAt returns the cursor position nearest a point across the box
+func (t *TextInput) runeAt(u *UI, x float64) int {
+	runes := []rune(t.shown())
+	left := t.Rect.Min.X + padding
+	t.offset = clamp(t.offset, 0, len(runes))
+	for i := t.offset; i < len(runes); i++ {
+		// past the middle of a rune puts the cursor after it
+		mid := left + u.TextWidth(string(runes[t.offset:i])) + u.TextWidth(string(runes[i]))/2
+		if x < mid {
+			return i
+		}
+	}
+	return len(runes)
+}
+
+func clamp(n, min, max int) int {
+	if n < min {
+		return min
+	}
+	if n > max {
+		return max
+	}
+	return n
+}
@@ -0,0 +1,215 @@
+package ui
+
+import (
+	"image/color"
+	"math"
+
+	"github.com/faiface/pixel"
+	"github.com/faiface/pixel/pixelgl"
+)
+
+// scrollBarWidth is how wide the bar beside a list with more rows than fit is drawn
+const scrollBarWidth = 4
+
+// Row is a line of a list
+type Row struct {
+	Text  string
+	Color color.Color // nil for the theme's
+}
+
+// List is a column of rows scrolled with the mouse wheel. A selectable list picks a row
+// when it is clicked, or with the arrow keys while focused, and activates the picked row
+// when it is clicked again or enter is pressed
+type List struct {
+	Box
+	Rows       []Row
+	Color      color.Color // background, or nil for none
+	Scroll     int         // index of the top row shown
+	Follow     bool        // stay scrolled to the bottom while there, as a log does
+	Selectable bool
+	Selected   int // index of the picked row, or -1 for none
+	OnSelect   func(i int) error
+	OnActivate func(i int) error
+
+	page int // rows which fit, as last laid out
+}
+
+// Focusable reports whether the list takes the keyboard
+func (l *List) Focusable() bool {
+	return l.Selectable
+}
+
+// SetRows replaces the rows, following them down if the list follows and was at the bottom
+func (l *List) SetRows(rows []Row) {
+	follow := l.Follow && l.AtBottom()
+	l.Rows = rows
+	if follow {
+		l.ScrollToBottom()
+	}
+	l.Scroll = clamp(l.Scroll, 0, l.maxScroll())
+}
+
+// Page is how many rows fit in the list
+func (l *List) Page() int {
+	if l.page < 1 {
+		return 1
+	}
+	return l.page
+}
+
+// AtBottom reports whether the last row is shown
+func (l *List) AtBottom() bool {
+	return l.Scroll >= l.maxScroll()
+}
+
+// ScrollBy scrolls down a number of rows, or up if negative
+func (l *List) ScrollBy(rows int) {
+	l.Scroll = clamp(l.Scroll+rows, 0, l.maxScroll())
+}
+
+// ScrollToBottom shows the last rows
+func (l *List) ScrollToBottom() {
+	l.Scroll = l.maxScroll()
+}
+
+// ScrollTo scrolls as little as needed to show a row
+func (l *List) ScrollTo(i int) {
+	if i < l.Scroll {
+		l.Scroll = i
+	}
+	if i >= l.Scroll+l.Page() {
+		l.Scroll = i - l.Page() + 1
+	}
+	l.Scroll = clamp(l.Scroll, 0, l.maxScroll())
+}
+
+func (l *List) maxScroll() int {
+	if len(l.Rows) <= l.Page() {
+		return 0
+	}
+	return len(l.Rows) - l.Page()
+}
+
+func (l *List) layout(u *UI) {
+	l.page = int((l.Rect.H() - 2*padding) / u.LineHeight())
+}
+
+// rowRect is where a row is drawn, counting from the top row shown
+func (l *List) rowRect(u *UI, shown int) pixel.Rect {
+	top := l.Rect.Max.Y - padding - float64(shown)*u.LineHeight()
+	return pixel.R(l.Rect.Min.X, top-u.LineHeight(), l.Rect.Max.X, top)
+}
+
+// rowAt returns the row shown at a height, if there is one
+func (l *List) rowAt(u *UI, y float64) (int, bool) {
+	shown := int(math.Floor((l.Rect.Max.Y - padding - y) / u.LineHeight()))
+	i := l.Scroll + shown
+	return i, shown >= 0 && shown < l.Page() && i < len(l.Rows)
+}
+
+func (l *List) Update(u *UI) error {
+	l.layout(u)
+	l.Scroll = clamp(l.Scroll-int(u.Scrolled(l)), 0, l.maxScroll())
+	if !l.Selectable {
+		return nil
+	}
+	if u.Clicked(l) {
+		if i, ok := l.rowAt(u, u.Mouse().Y); ok {
+			if i == l.Selected {
+				return l.activate()
+			}
+			return l.selectRow(i)
+		}
+	}
+	if !u.Focused(l) || len(l.Rows) == 0 {
+		return nil
+	}
+	switch {
+	case u.Repeated(pixelgl.KeyUp):
+		return l.selectRow(max(l.Selected-1, 0))
+	case u.Repeated(pixelgl.KeyDown):
+		return l.selectRow(min(l.Selected+1, len(l.Rows)-1))
+	case u.Repeated(pixelgl.KeyPageUp):
+		return l.selectRow(max(l.Selected-l.Page(), 0))
+	case u.Repeated(pixelgl.KeyPageDown):
+		return l.selectRow(min(l.Selected+l.Page(), len(l.Rows)-1))
+	case u.JustPressed(pixelgl.KeyHome):
+		return l.selectRow(0)
+	case u.JustPressed(pixelgl.KeyEnd):
+		return l.selectRow(len(l.Rows) - 1)
+	case u.JustPressed(pixelgl.KeyEnter):
+		return l.activate()
+	}
+	return nil
+}
+
+func (l *List) selectRow(i int) error {
+	l.Selected = i
+	l.ScrollTo(i)
+	if l.OnSelect != nil {
+		return l.OnSelect(i)
+	}
+	return nil
+}
+
+func (l *List) activate() error {
+	if l.Selected < 0 || l.Selected >= len(l.Rows) || l.OnActivate == nil {
+		return nil
+	}
+	return l.OnActivate(l.Selected)
+}
+
+func (l *List) Draw(u *UI) {
+	l.layout(u)
+	l.Scroll = clamp(l.Scroll, 0, l.maxScroll())
+	if l.Color != nil {
+		u.Fill(l.Rect, l.Color)
+	}
+	if u.Focused(l) {
+		u.Outline(l.Rect, u.Theme.Focus)
+	}
+	hovered := -1
+	if l.Selectable && u.Hovered(l) {
+		if i, ok := l.rowAt(u, u.Mouse().Y); ok {
+			hovered = i
+		}
+	}
+	for shown := 0; shown < l.Page() && l.Scroll+shown < len(l.Rows); shown++ {
+		i := l.Scroll + shown
+		r := l.rowRect(u, shown)
+		switch {
+		case l.Selectable && i == l.Selected:
+			u.Fill(r, u.Theme.Selected)
+		case i == hovered:
+			u.Fill(r, u.Theme.Hover)
+		}
+		u.TextIn(l.Rows[i].Text, r, AlignLeft, or(l.Rows[i].Color, u.Theme.Text))
+	}
+	if len(l.Rows) > l.Page() {
+		l.drawScrollBar(u)
+	}
+}
+
+// drawScrollBar shows how much of the list is in view and where
+func (l *List) drawScrollBar(u *UI) {
+	track := pixel.R(l.Rect.Max.X-scrollBarWidth-1, l.Rect.Min.Y+padding, l.Rect.Max.X-1, l.Rect.Max.Y-padding)
+	u.Fill(track, u.Theme.Button)
+	rows := float64(len(l.Rows))
+	top := track.Max.Y - track.H()*float64(l.Scroll)/rows
+	height := track.H() * float64(l.Page()) / rows
+	u.Fill(pixel.R(track.Min.X, top-height, track.Max.X, top), u.Theme.Dim)
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,43 @@
+package ui
+
+import (
+	"image/color"
+)
+
+// Panel is a background for the widgets on it. A click on the panel itself takes the
+// keyboard away from any widget, and doesn't reach the game
+type Panel struct {
+	Box
+	Color   color.Color // nil for the theme's
+	Widgets []Widget
+}
+
+// Children are the widgets on the panel
+func (p *Panel) Children() []Widget {
+	return p.Widgets
+}
+
+func (p *Panel) Update(u *UI) error {
+	return updateAll(u, p.Widgets)
+}
+
+func (p *Panel) Draw(u *UI) {
+	u.Fill(p.Rect, or(p.Color, u.Theme.Panel))
+	drawAll(u, p.Widgets)
+}
+
+// Label is a line of text
+type Label struct {
+	Box
+	Text  string
+	Color color.Color // nil for the theme's
+	Align Align
+}
+
+func (l *Label) Update(u *UI) error {
+	return nil
+}
+
+func (l *Label) Draw(u *UI) {
+	u.TextIn(l.Text, l.Rect, l.Align, or(l.Color, u.Theme.Text))
+}
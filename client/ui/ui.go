@@ -0,0 +1,378 @@
+// Package ui is a small retained-mode toolkit for the client's windows and menus.
+//
+// Widgets are built once and kept, their fields changed as the game changes, and a UI
+// hands them input and draws them every frame. The widget under the mouse takes the
+// mouse and the focused widget takes the keyboard. The UI reports both, so the game can
+// leave alone input meant for a widget. Widgets are placed in screen space by whoever
+// owns them, usually every frame from the size of the window
+package ui
+
+import (
+	"image/color"
+
+	"github.com/faiface/pixel"
+	"github.com/faiface/pixel/imdraw"
+	"github.com/faiface/pixel/pixelgl"
+	"github.com/faiface/pixel/text"
+	"golang.org/x/image/colornames"
+)
+
+// Widget is an element of the interface
+type Widget interface {
+	// Bounds is where the widget is drawn and takes the mouse, in screen space
+	Bounds() pixel.Rect
+	// SetBounds moves the widget
+	SetBounds(r pixel.Rect)
+	// Visible reports whether the widget is drawn and takes input
+	Visible() bool
+	// Update reacts to this frame's input
+	Update(u *UI) error
+	// Draw draws the widget to the window
+	Draw(u *UI)
+}
+
+// Container is a widget holding others, which are drawn over it and take the mouse before it
+type Container interface {
+	Widget
+	Children() []Widget
+}
+
+// Focusable is a widget which can take the keyboard
+type Focusable interface {
+	Widget
+	Focusable() bool
+}
+
+// Box is the position and visibility of a widget, for embedding
+type Box struct {
+	Rect   pixel.Rect
+	Hidden bool
+}
+
+// Bounds is where the widget is, in screen space
+func (b *Box) Bounds() pixel.Rect {
+	return b.Rect
+}
+
+// SetBounds moves the widget
+func (b *Box) SetBounds(r pixel.Rect) {
+	b.Rect = r
+}
+
+// Visible reports whether the widget is shown
+func (b *Box) Visible() bool {
+	return !b.Hidden
+}
+
+// Align is where text sits across a widget
+type Align int
+
+const (
+	AlignLeft Align = iota
+	AlignCenter
+)
+
+// padding is the gap between the edge of a widget and its text
+const padding = 5
+
+// Theme is the colours widgets are drawn in
+type Theme struct {
+	Panel    color.Color // background of panels
+	Text     color.Color
+	Dim      color.Color // placeholders, disabled buttons and scroll bars
+	Button   color.Color
+	Hover    color.Color // buttons and list rows under the mouse
+	Selected color.Color // the picked row of a list
+	Input    color.Color // background of text inputs
+	Focus    color.Color // outline of the widget with the keyboard
+}
+
+// DefaultTheme is dark and see-through, so the world shows behind it
+var DefaultTheme = Theme{
+	Panel:    pixel.RGB(0, 0, 0).Mul(pixel.Alpha(0.7)),
+	Text:     colornames.White,
+	Dim:      colornames.Gray,
+	Button:   pixel.RGB(0.2, 0.2, 0.25),
+	Hover:    colornames.Slategray,
+	Selected: colornames.Steelblue,
+	Input:    pixel.RGB(0, 0, 0).Mul(pixel.Alpha(0.8)),
+	Focus:    colornames.Lightskyblue,
+}
+
+// UI is the root of the widgets in a window. Widgets added later are drawn over
+// those added earlier and take the mouse before them
+type UI struct {
+	Theme Theme
+
+	win     *pixelgl.Window
+	txt     *text.Text
+	imd     *imdraw.IMDraw
+	widgets []Widget
+
+	hover         Widget // the topmost widget under the mouse
+	focus         Widget // the widget taking the keyboard
+	capturedMouse bool
+	capturedKeys  bool
+}
+
+// New returns an empty UI for a window, writing with the glyphs of an atlas
+func New(win *pixelgl.Window, atlas *text.Atlas) *UI {
+	return &UI{
+		Theme: DefaultTheme,
+		win:   win,
+		txt:   text.New(pixel.ZV, atlas),
+		imd:   imdraw.New(nil),
+	}
+}
+
+// Add puts widgets on top of those already added
+func (u *UI) Add(widgets ...Widget) {
+	u.widgets = append(u.widgets, widgets...)
+}
+
+// Update hands this frame's input to the widgets. A click moves the focus to the widget
+// clicked, or takes it away if that widget can't take the keyboard. The first error
+// returned by a widget stops the update
+func (u *UI) Update() error {
+	shown := visible(u.widgets)
+	if u.focus != nil && !shown[u.focus] {
+		u.focus = nil
+	}
+	u.hover = widgetAt(u.widgets, u.win.MousePosition())
+	u.capturedMouse = u.hover != nil
+	for b := pixelgl.MouseButtonLeft; b <= pixelgl.MouseButtonLast; b++ {
+		if !u.win.JustPressed(b) {
+			continue
+		}
+		u.focus = nil
+		if f, ok := u.hover.(Focusable); ok && f.Focusable() {
+			u.focus = u.hover
+		}
+		break
+	}
+	// the keyboard stays captured for the frame a widget lets go of it,
+	// so the key which ended the typing doesn't reach the game too
+	u.capturedKeys = u.focus != nil
+	if err := updateAll(u, u.widgets); err != nil {
+		return err
+	}
+	u.capturedKeys = u.capturedKeys || u.focus != nil
+	return nil
+}
+
+// Draw draws the widgets to the window, which must be in screen space
+func (u *UI) Draw() {
+	drawAll(u, u.widgets)
+}
+
+// CapturedMouse reports whether the mouse was over a widget this frame, so its buttons
+// and wheel were meant for the interface rather than the game
+func (u *UI) CapturedMouse() bool {
+	return u.capturedMouse
+}
+
+// CapturedKeys reports whether a widget had the keyboard this frame
+func (u *UI) CapturedKeys() bool {
+	return u.capturedKeys
+}
+
+// Focus gives a widget the keyboard
+func (u *UI) Focus(w Widget) {
+	u.focus = w
+	u.capturedKeys = true
+}
+
+// Blur takes the keyboard away from whichever widget has it
+func (u *UI) Blur() {
+	u.focus = nil
+}
+
+// Focused reports whether a widget has the keyboard
+func (u *UI) Focused(w Widget) bool {
+	return u.focus == w
+}
+
+// Hovered reports whether the mouse is over a widget, and not over another drawn on top of it
+func (u *UI) Hovered(w Widget) bool {
+	return u.hover == w
+}
+
+// Clicked reports whether a widget was clicked with the left button this frame
+func (u *UI) Clicked(w Widget) bool {
+	return u.hover == w && u.win.JustPressed(pixelgl.MouseButtonLeft)
+}
+
+// Scrolled returns how far the mouse wheel turned over a widget this frame, up being positive
+func (u *UI) Scrolled(w Widget) float64 {
+	if u.hover != w {
+		return 0
+	}
+	return u.win.MouseScroll().Y
+}
+
+// Mouse is the position of the mouse in screen space
+func (u *UI) Mouse() pixel.Vec {
+	return u.win.MousePosition()
+}
+
+// JustPressed reports whether a key was pressed this frame, for the focused widget
+func (u *UI) JustPressed(b pixelgl.Button) bool {
+	return u.win.JustPressed(b)
+}
+
+// Repeated reports whether a key was pressed or held long enough to repeat this frame
+func (u *UI) Repeated(b pixelgl.Button) bool {
+	return u.win.JustPressed(b) || u.win.Repeated(b)
+}
+
+// Typed returns the text typed this frame
+func (u *UI) Typed() string {
+	return u.win.Typed()
+}
+
+// LineHeight is the height of a line of text
+func (u *UI) LineHeight() float64 {
+	return u.txt.LineHeight
+}
+
+// TextWidth is how wide a string is drawn
+func (u *UI) TextWidth(s string) float64 {
+	return u.txt.BoundsOf(s).W()
+}
+
+// Baseline returns the height to write a line of text at to centre it in a rectangle
+func (u *UI) Baseline(r pixel.Rect) float64 {
+	// a quarter of a line hangs below the baseline
+	return r.Min.Y + (r.H()-u.txt.LineHeight)/2 + u.txt.LineHeight/4
+}
+
+// Fill draws a solid rectangle
+func (u *UI) Fill(r pixel.Rect, c color.Color) {
+	u.imd.Clear()
+	u.imd.Color = c
+	u.imd.Push(r.Min, r.Max)
+	u.imd.Rectangle(0)
+	u.imd.Draw(u.win)
+}
+
+// Outline draws the edge of a rectangle
+func (u *UI) Outline(r pixel.Rect, c color.Color) {
+	u.imd.Clear()
+	u.imd.Color = c
+	u.imd.Push(r.Min, r.Max)
+	u.imd.Rectangle(1)
+	u.imd.Draw(u.win)
+}
+
+// Text writes a line of text from a point on its baseline
+func (u *UI) Text(s string, at pixel.Vec, c color.Color) {
+	u.txt.Clear()
+	u.txt.Dot = u.txt.Orig
+	u.txt.WriteString(s)
+	u.txt.DrawColorMask(u.win, pixel.IM.Moved(at), c)
+}
+
+// TextIn writes a line of text centred from top to bottom in a rectangle
+func (u *UI) TextIn(s string, r pixel.Rect, align Align, c color.Color) {
+	x := r.Min.X + padding
+	if align == AlignCenter {
+		x = r.Center().X - u.TextWidth(s)/2
+	}
+	u.Text(s, pixel.V(x, u.Baseline(r)), c)
+}
+
+// Column lays widgets out in rows of a height, from the top of a rectangle down.
+// A nil widget leaves an empty row. It returns what is left of the rectangle below
+func Column(r pixel.Rect, height float64, widgets ...Widget) pixel.Rect {
+	top := r.Max.Y
+	for _, w := range widgets {
+		if w != nil {
+			w.SetBounds(pixel.R(r.Min.X, top-height, r.Max.X, top))
+		}
+		top -= height
+	}
+	return pixel.R(r.Min.X, r.Min.Y, r.Max.X, top)
+}
+
+// Take cuts a strip of a height off the top of a rectangle, returning it and what is left below
+func Take(r pixel.Rect, height float64) (pixel.Rect, pixel.Rect) {
+	return pixel.R(r.Min.X, r.Max.Y-height, r.Max.X, r.Max.Y), pixel.R(r.Min.X, r.Min.Y, r.Max.X, r.Max.Y-height)
+}
+
+// Split lays widgets out side by side across a rectangle, sharing its width with a gap between each
+func Split(r pixel.Rect, gap float64, widgets ...Widget) {
+	if len(widgets) == 0 {
+		return
+	}
+	width := (r.W() - gap*float64(len(widgets)-1)) / float64(len(widgets))
+	for i, w := range widgets {
+		left := r.Min.X + float64(i)*(width+gap)
+		w.SetBounds(pixel.R(left, r.Min.Y, left+width, r.Max.Y))
+	}
+}
+
+// updateAll updates the visible widgets in a list, topmost first
+func updateAll(u *UI, widgets []Widget) error {
+	for i := len(widgets) - 1; i >= 0; i-- {
+		if !widgets[i].Visible() {
+			continue
+		}
+		if err := widgets[i].Update(u); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// drawAll draws the visible widgets in a list, bottom first
+func drawAll(u *UI, widgets []Widget) {
+	for _, w := range widgets {
+		if w.Visible() {
+			w.Draw(u)
+		}
+	}
+}
+
+// widgetAt returns the topmost visible widget at a point, looking inside containers, or nil
+func widgetAt(widgets []Widget, pos pixel.Vec) Widget {
+	for i := len(widgets) - 1; i >= 0; i-- {
+		w := widgets[i]
+		if !w.Visible() || !w.Bounds().Contains(pos) {
+			continue
+		}
+		if c, ok := w.(Container); ok {
+			if inner := widgetAt(c.Children(), pos); inner != nil {
+				return inner
+			}
+		}
+		return w
+	}
+	return nil
+}
+
+// visible returns the set of widgets shown, which are those visible inside visible containers
+func visible(widgets []Widget) map[Widget]bool {
+	shown := make(map[Widget]bool)
+	var walk func(widgets []Widget)
+	walk = func(widgets []Widget) {
+		for _, w := range widgets {
+			if !w.Visible() {
+				continue
+			}
+			shown[w] = true
+			if c, ok := w.(Container); ok {
+				walk(c.Children())
+			}
+		}
+	}
+	walk(widgets)
+	return shown
+}
+
+// or returns a colour, or a fallback if it is nil
+func or(c, fallback color.Color) color.Color {
+	if c == nil {
+		return fallback
+	}
+	return c
+}
@@ -1,7 +1,6 @@
 package main
 
 import (
-	"log"
 	"math"
 	"time"
 
@@ -9,49 +8,158 @@ import (
 
 	"github.com/faiface/pixel"
 	"github.com/faiface/pixel/imdraw"
+	"github.com/mmogo/mmo/shared"
 )
 
-func LoadWorld() *pixel.Batch {
-	t1 := time.Now()
+const (
+	chunkTiles  = 16 // tiles along each side of a chunk
+	chunkMargin = 1  // chunks built beyond the edge of the window, so walking doesn't reveal gaps
+	chunkEvict  = 3  // chunks further than this beyond the edge of the window are thrown away
+)
+
+// chunkKey is the position of a chunk on the grid of chunks. Chunk 0,0 starts at tile 0,0
+type chunkKey struct {
+	X, Y int
+}
+
+// chunk is the drawn ground of a square of tiles
+type chunk struct {
+	ground *pixel.Batch
+	grid   *imdraw.IMDraw // tile outlines, built the first time the debug grid is shown
+}
+
+// terrain draws the ground of the world, building it a chunk at a time around the camera
+// and dropping chunks once they are far away, so the size of the map costs nothing up front
+type terrain struct {
+	grass   pixel.Picture
+	sprite  *pixel.Sprite
+	tileMap *shared.TileMap
+	chunks  map[chunkKey]*chunk
+}
+
+func newTerrain(tileMap *shared.TileMap) (*terrain, error) {
 	grass, err := loadPicture("sprites/grass.png")
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
 	}
-	ground := pixel.NewSprite(grass, grass.Bounds())
-	batch := pixel.NewBatch(&pixel.TrianglesData{}, grass)
-	batch.SetMatrix(pixel.IM.Rotated(pixel.ZV, 45*(math.Pi/180)).ScaledXY(pixel.ZV, pixel.V(1, 0.5)))
-	tilesize := grass.Bounds().Max.X // 64
-	mapsize := tilesize * 100        // 6400 wide/high
-	var i int
-	for y := -mapsize / 2; y <= mapsize/2; y = y + tilesize {
-		for x := -mapsize / 2.00; x <= mapsize/2; x = x + tilesize {
-			i++
-			ground.Draw(batch, pixel.IM.Moved(pixel.V(x, y)))
+	return &terrain{
+		grass:   grass,
+		sprite:  pixel.NewSprite(grass, grass.Bounds()),
+		tileMap: tileMap,
+		chunks:  make(map[chunkKey]*chunk),
+	}, nil
+}
+
+// draw draws the ground seen through a rectangle of screen space, and the tile grid if asked
+func (t *terrain) draw(target pixel.Target, visible pixel.Rect, grid bool) {
+	min, max := t.chunkRange(visible)
+	for key := range t.chunks {
+		if key.X < min.X-chunkEvict || key.X > max.X+chunkEvict || key.Y < min.Y-chunkEvict || key.Y > max.Y+chunkEvict {
+			delete(t.chunks, key)
+		}
+	}
+	for y := min.Y - chunkMargin; y <= max.Y+chunkMargin; y++ {
+		for x := min.X - chunkMargin; x <= max.X+chunkMargin; x++ {
+			key := chunkKey{x, y}
+			c, ok := t.chunks[key]
+			if !ok {
+				c = t.buildChunk(key)
+				t.chunks[key] = c
+			}
+			if x < min.X || x > max.X || y < min.Y || y > max.Y {
+				continue
+			}
+			c.ground.Draw(target)
+			if grid {
+				if c.grid == nil {
+					c.grid = t.buildGrid(key)
+				}
+				c.grid.Draw(target)
+			}
 		}
 	}
-	log.Printf("world render: %v iter took %s", i, time.Since(t1))
-	return batch
 }
 
-func LoadGrid() *pixel.Batch {
-	t1 := time.Now()
-	batch := pixel.NewBatch(&pixel.TrianglesData{}, nil)
-	batch.SetMatrix(pixel.IM.Rotated(pixel.ZV, 45*(math.Pi/180)).ScaledXY(pixel.ZV, pixel.V(1, 0.5)))
-	imd := imdraw.New(nil)
-	imd.Color = pixel.ToRGBA(colornames.Red)
-	var i int
-	tilesize := 64.00
-	mapsize := 6400.00 // height and width
-	for y := -mapsize / 2; y <= mapsize/2; y = y + tilesize {
-		for x := -mapsize / 2; x <= mapsize/2; x = x + tilesize {
-			i++
-			imd.Clear()
-			imd.Push(pixel.V(x-(tilesize/2), y-(tilesize/2)))
-			imd.Push(pixel.V(x+(tilesize/2), y+(tilesize/2)))
-			imd.Rectangle(1)
-			imd.Draw(batch)
+// chunkRange returns the corners of the range of chunks seen through a rectangle of screen space
+func (t *terrain) chunkRange(visible pixel.Rect) (chunkKey, chunkKey) {
+	corners := []pixel.Vec{
+		visible.Min, visible.Max,
+		pixel.V(visible.Min.X, visible.Max.Y), pixel.V(visible.Max.X, visible.Min.Y),
+	}
+	min := chunkKey{math.MaxInt32, math.MaxInt32}
+	max := chunkKey{math.MinInt32, math.MinInt32}
+	for _, corner := range corners {
+		key := chunkOf(shared.TileAt(shared.IsoToMap(corner)))
+		if key.X < min.X {
+			min.X = key.X
+		}
+		if key.Y < min.Y {
+			min.Y = key.Y
+		}
+		if key.X > max.X {
+			max.X = key.X
+		}
+		if key.Y > max.Y {
+			max.Y = key.Y
 		}
 	}
-	log.Printf("grid render: %v iter took %s", i, time.Since(t1))
-	return batch
+	return min, max
+}
+
+// chunkOf returns the chunk a tile is in
+func chunkOf(tile shared.Tile) chunkKey {
+	return chunkKey{floorDiv(tile.X, chunkTiles), floorDiv(tile.Y, chunkTiles)}
+}
+
+func floorDiv(a, b int) int {
+	if a < 0 {
+		return -((-a + b - 1) / b)
+	}
+	return a / b
+}
+
+// tiles calls f for each tile of a chunk which is on the map
+func (t *terrain) tiles(key chunkKey, f func(tile shared.Tile)) {
+	for y := key.Y * chunkTiles; y < (key.Y+1)*chunkTiles; y++ {
+		for x := key.X * chunkTiles; x < (key.X+1)*chunkTiles; x++ {
+			tile := shared.Tile{X: x, Y: y}
+			if tile.X < t.tileMap.Min.X || tile.X > t.tileMap.Max.X || tile.Y < t.tileMap.Min.Y || tile.Y > t.tileMap.Max.Y {
+				continue
+			}
+			f(tile)
+		}
+	}
+}
+
+func (t *terrain) buildChunk(key chunkKey) *chunk {
+	t1 := time.Now()
+	batch := pixel.NewBatch(&pixel.TrianglesData{}, t.grass)
+	batch.SetMatrix(shared.IsoMatrix)
+	scale := shared.TileSize / t.grass.Bounds().W()
+	count := 0
+	t.tiles(key, func(tile shared.Tile) {
+		count++
+		matrix := pixel.IM.Scaled(pixel.ZV, scale).Moved(shared.TileCenter(tile))
+		if t.tileMap.Blocked(tile) {
+			// darkened as on the minimap, so walls can be seen
+			t.sprite.DrawColorMask(batch, matrix, colornames.Dimgray)
+			return
+		}
+		t.sprite.Draw(batch, matrix)
+	})
+	renderLog.Debug("chunk built", "x", key.X, "y", key.Y, "tiles", count, "took", time.Since(t1))
+	return &chunk{ground: batch}
+}
+
+func (t *terrain) buildGrid(key chunkKey) *imdraw.IMDraw {
+	imd := imdraw.New(nil)
+	imd.SetMatrix(shared.IsoMatrix)
+	imd.Color = colornames.Red
+	half := pixel.V(shared.TileSize/2, shared.TileSize/2)
+	t.tiles(key, func(tile shared.Tile) {
+		center := shared.TileCenter(tile)
+		imd.Push(center.Sub(half), center.Add(half))
+		imd.Rectangle(1)
+	})
+	return imd
 }
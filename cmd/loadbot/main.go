@@ -0,0 +1,306 @@
+// Command loadbot load tests a server with simulated players. Each bot connects
+// like the game client does and plays through the client's game world, without
+// drawing it, walking about and chatting. The round trip times,
+// update rates and errors seen by all of them are reported as it runs, e.g.
+//
+//	loadbot -addr localhost:8080 -bots 2000 -ramp 1m -duration 10m
+//
+// Bots don't log in to accounts, so the server must be run with -open
+package main
+
+import (
+	"flag"
+	"fmt"
+	"math/rand"
+	"net"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/mmogo/mmo/client/game"
+	"github.com/mmogo/mmo/client/network"
+	"github.com/mmogo/mmo/shared"
+	"github.com/mmogo/mmo/shared/logging"
+)
+
+var botLog = logging.New("loadbot")
+
+// directions bots pick from when they change course
+var directions = []shared.Direction{
+	shared.LEFT, shared.RIGHT, shared.UP, shared.DOWN,
+	shared.UPLEFT, shared.UPRIGHT, shared.DOWNLEFT, shared.DOWNRIGHT,
+}
+
+var phrases = []string{
+	"hello", "anyone around?", "lfg", "nice weather", "/shout selling potions", "brb", "/who",
+}
+
+type behaviour struct {
+	walkInterval time.Duration // between move requests, 0 to stand still
+	turnChance   float64       // chance of changing direction on each move
+	chatInterval time.Duration // mean time between chat messages, 0 to keep quiet
+}
+
+func main() {
+	addr := flag.String("addr", "localhost:8080", "address of the server")
+	protocol := flag.String("protocol", "udp", fmt.Sprintf("network protocol to use. available %s | %s", shared.ProtocolTCP, shared.ProtocolUDP))
+	bots := flag.Int("bots", 100, "number of bots to run")
+	ramp := flag.Duration("ramp", 10*time.Second, "time over which the bots connect")
+	duration := flag.Duration("duration", 0, "how long to run for. runs until interrupted if 0")
+	walk := flag.Duration("walk", 100*time.Millisecond, "time between move requests. bots stand still if 0")
+	turn := flag.Float64("turn", 0.05, "chance a bot changes direction on each move")
+	chat := flag.Duration("chat", 30*time.Second, "mean time between chat messages per bot. bots keep quiet if 0")
+	prefix := flag.String("prefix", "bot", "prefix of bot player IDs")
+	report := flag.Duration("report", 5*time.Second, "how often to report statistics")
+	logLevels := flag.String("log", "warn", "log levels, a default then subsystem=level pairs, e.g. warn,net=debug")
+	flag.Parse()
+	if err := logging.Configure(*logLevels); err != nil {
+		botLog.Fatal("bad -log", "err", err)
+	}
+	if *bots < 1 || *report <= 0 {
+		botLog.Fatal("-bots and -report must be positive")
+	}
+
+	b := behaviour{walkInterval: *walk, turnChance: *turn, chatInterval: *chat}
+	stats := newStats()
+	stop := make(chan struct{})
+	var wg sync.WaitGroup
+	go func() {
+		for i := 0; i < *bots; i++ {
+			select {
+			case <-stop:
+				return
+			default:
+			}
+			wg.Add(1)
+			go func(id string) {
+				defer wg.Done()
+				runBot(*protocol, *addr, id, b, stats, stop)
+			}(fmt.Sprintf("%s%v", *prefix, i))
+			time.Sleep(*ramp / time.Duration(*bots))
+		}
+	}()
+
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	var done <-chan time.Time
+	if *duration > 0 {
+		done = time.After(*duration)
+	}
+	ticker := time.NewTicker(*report)
+	defer ticker.Stop()
+	started := time.Now()
+	for running := true; running; {
+		select {
+		case <-ticker.C:
+			fmt.Println(stats.report(*report))
+		case <-done:
+			running = false
+		case <-interrupt:
+			running = false
+		}
+	}
+	close(stop)
+	wg.Wait()
+	fmt.Println(stats.summary(time.Since(started)))
+}
+
+// runBot plays as one simulated player until stop is closed, reconnecting if the connection fails
+func runBot(protocol, addr, id string, b behaviour, stats *stats, stop chan struct{}) {
+	for {
+		err := playBot(protocol, addr, id, b, stats, stop)
+		if err == nil {
+			return
+		}
+		stats.error(err)
+		botLog.Warn("bot failed", "id", id, "err", err)
+		select {
+		case <-stop:
+			return
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+// playBot connects as a player and walks and chats until stop is closed or something fails
+func playBot(protocol, addr, id string, b behaviour, stats *stats, stop chan struct{}) error {
+	conn, err := network.Connect(protocol, addr, id, "")
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	atomic.AddInt64(&stats.connected, 1)
+	defer atomic.AddInt64(&stats.connected, -1)
+
+	world := game.NewWorld(id)
+	errc := make(chan error, 1)
+	go func() { errc <- receive(conn, world, stats) }()
+
+	random := rand.New(rand.NewSource(time.Now().UnixNano()))
+	direction := directions[random.Intn(len(directions))]
+	keepAlive := time.NewTicker(network.KeepAliveInterval)
+	defer keepAlive.Stop()
+	var walk <-chan time.Time
+	if b.walkInterval > 0 {
+		walkTicker := time.NewTicker(b.walkInterval)
+		defer walkTicker.Stop()
+		walk = walkTicker.C
+	}
+	nextChat := func() <-chan time.Time {
+		if b.chatInterval <= 0 {
+			return nil
+		}
+		// spread messages out so the bots don't all speak at once
+		return time.After(time.Duration(random.ExpFloat64() * float64(b.chatInterval)))
+	}
+	chat := nextChat()
+	last := time.Now()
+
+	for {
+		var err error
+		select {
+		case <-stop:
+			return nil
+		case err = <-errc:
+		case <-keepAlive.C:
+			err = network.SendKeepAlive(conn)
+		case <-walk:
+			// moves are predicted as the client predicts them, so the world does the same work
+			world.Step(time.Since(last).Seconds())
+			last = time.Now()
+			if random.Float64() < b.turnChance {
+				direction = directions[random.Intn(len(directions))]
+			}
+			err = world.Move(direction.ToVec(), conn)
+		case <-chat:
+			err = world.Speak(phrases[random.Intn(len(phrases))], conn)
+			chat = nextChat()
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// receive applies the updates a bot is sent to its world and counts them, timing the
+// round trip of its own moves. With many bots the world state is too big for one
+// message, so its parts are checked to arrive whole and in order
+func receive(conn net.Conn, world *game.World, stats *stats) error {
+	nextPart := 0
+	for {
+		msg, err := network.Receive(conn)
+		if err != nil {
+			return err
+		}
+		if msg.Update == nil {
+			continue
+		}
+		if state := msg.Update.WorldState; state != nil {
+			if state.Part != nextPart || state.Part >= state.Parts {
+				return fmt.Errorf("world state part %v of %v after part %v", state.Part+1, state.Parts, nextPart)
+			}
+			if nextPart = state.Part + 1; nextPart == state.Parts {
+				nextPart = 0
+			}
+		}
+		if moved := msg.Update.EntityMoved; moved != nil && moved.ID == world.PlayerID() && !moved.RequestTime.IsZero() {
+			stats.roundTrip(time.Since(moved.RequestTime))
+		}
+		world.ApplyUpdate(msg.Update)
+		stats.update(msg.Type())
+	}
+}
+
+// stats collects what the bots see. Round trip times and update counts are reset at each report
+type stats struct {
+	connected int64
+
+	lock         sync.Mutex
+	rtts         []time.Duration
+	updates      map[string]uint64
+	errors       map[string]uint64
+	totalUpdates uint64
+	totalErrors  uint64
+	worstRTT     time.Duration
+}
+
+func newStats() *stats {
+	return &stats{
+		updates: make(map[string]uint64),
+		errors:  make(map[string]uint64),
+	}
+}
+
+func (s *stats) roundTrip(rtt time.Duration) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.rtts = append(s.rtts, rtt)
+	if rtt > s.worstRTT {
+		s.worstRTT = rtt
+	}
+}
+
+func (s *stats) update(kind string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.updates[kind]++
+	s.totalUpdates++
+}
+
+func (s *stats) error(err error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.errors[err.Error()]++
+	s.totalErrors++
+}
+
+// report describes the interval since the last report and starts a new one
+func (s *stats) report(interval time.Duration) string {
+	s.lock.Lock()
+	rtts, updates, errors := s.rtts, s.updates, s.errors
+	s.rtts, s.updates, s.errors = nil, make(map[string]uint64), make(map[string]uint64)
+	s.lock.Unlock()
+
+	lines := []string{fmt.Sprintf("%s  connected %v  rtt %s",
+		time.Now().Format("15:04:05"), atomic.LoadInt64(&s.connected), percentiles(rtts))}
+	total := uint64(0)
+	kinds := []string{}
+	for kind, n := range updates {
+		total += n
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+	rates := []string{}
+	for _, kind := range kinds {
+		rates = append(rates, fmt.Sprintf("%s %.1f", kind, float64(updates[kind])/interval.Seconds()))
+	}
+	lines = append(lines, fmt.Sprintf("  updates/s %.1f (%s)", float64(total)/interval.Seconds(), strings.Join(rates, ", ")))
+	for err, n := range errors {
+		lines = append(lines, fmt.Sprintf("  error x%v: %s", n, err))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// summary describes the whole run
+func (s *stats) summary(took time.Duration) string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return fmt.Sprintf("ran %v  updates %v (%.1f/s)  errors %v  worst rtt %v",
+		took/time.Second*time.Second, s.totalUpdates, float64(s.totalUpdates)/took.Seconds(), s.totalErrors, s.worstRTT)
+}
+
+// percentiles summarises round trip times as p50/p95/p99/max
+func percentiles(rtts []time.Duration) string {
+	if len(rtts) == 0 {
+		return "n/a"
+	}
+	sort.Slice(rtts, func(i, j int) bool { return rtts[i] < rtts[j] })
+	at := func(p float64) time.Duration {
+		return rtts[int(p*float64(len(rtts)-1))] / time.Microsecond * time.Microsecond
+	}
+	return fmt.Sprintf("p50 %v  p95 %v  p99 %v  max %v", at(0.5), at(0.95), at(0.99), at(1))
+}
@@ -2,11 +2,11 @@ package main
 
 import (
 	"crypto/md5"
+	"encoding/json"
 	"flag"
 	"fmt"
 	"io"
 	"io/ioutil"
-	"log"
 	"net/http"
 	"net/url"
 	"os"
@@ -16,62 +16,51 @@ import (
 	"strings"
 
 	"github.com/layer-x/layerx-commons/lxhttpclient"
-	"github.com/pborman/uuid"
+	"github.com/mmogo/mmo/shared"
+	"github.com/mmogo/mmo/shared/logging"
 )
 
 var addr = flag.String("addr", "localhost:8080", "http service address")
-var playerID = flag.String("id", "", "player id to use")
+var playerID = flag.String("id", "", "player id to play without logging in, on servers run with -open")
 var confFile = flag.String("conf", "login.txt", "login config file")
 var protocol = flag.String("protocol", "udp", fmt.Sprintf("network protocol to use."))
+var logLevels = flag.String("log", "info", "log levels, a default then subsystem=level pairs, e.g. info,patcher=debug")
+
+var patcherLog = logging.New("patcher")
+
+// serverFile is where the servers to choose from are saved for the client
+const serverFile = "servers.json"
 
 func main() {
 	flag.Parse()
 
 	logFile, err := os.Create("game.log")
 	if err != nil {
-		log.Fatal(err)
+		patcherLog.Fatal("creating game.log", "err", err)
 	}
 
 	out := io.MultiWriter(logFile, os.Stdout)
+	logging.SetOutput(out)
+	if err := logging.Configure(*logLevels); err != nil {
+		patcherLog.Fatal("bad -log", "err", err)
+	}
 
-	logger := log.New(out, "", log.LstdFlags)
-
-	if *playerID == "" {
-		confData, err := ioutil.ReadFile(*confFile)
-		if err != nil {
-			logger.Fatalf("%s not found: %v", *confFile, err)
-		}
+	if confData, err := ioutil.ReadFile(*confFile); err == nil {
 		lines := strings.Split(string(confData), "\n")
 		for _, line := range lines {
-			line = strings.Replace(line, " ", "", -1)
-			if strings.Contains(line, "server=") {
-				*addr = strings.Replace(line, "server=", "", -1)
-			}
-			if *playerID == "" && strings.Contains(line, "player_id=") {
-				*playerID = strings.Replace(line, "player_id=", "", -1)
-			}
-		}
-		if *playerID == "" {
-			*playerID = uuid.New()
-
-			//in case line is blank
-			updatedConf := strings.Replace(string(confData), "player_id=", "", -1)
-
-			conf, err := os.Create(*confFile)
-			if err != nil {
-				logger.Fatal(err)
+			line = strings.TrimSpace(strings.Replace(line, " ", "", -1))
+			if strings.HasPrefix(line, "server=") {
+				*addr = strings.TrimPrefix(line, "server=")
 			}
-			if runtime.GOOS == "windows" {
-				if _, err := fmt.Fprintf(conf, "%s\r\nplayer_id=%s", updatedConf, *playerID); err != nil {
-					logger.Fatal(err)
-				}
-			} else {
-				if _, err := fmt.Fprintf(conf, "%s\nplayer_id=%s", updatedConf, *playerID); err != nil {
-					logger.Fatal(err)
-				}
+			if strings.HasPrefix(line, "player_id=") {
+				// from before accounts, when the ID was all it took to play. Servers now want
+				// a login, so passing it on would only get the player refused
+				patcherLog.Warn("ignoring player_id in the config, log in to an account instead. an admin can give the character to it with claim",
+					"file", *confFile, "player_id", strings.TrimPrefix(line, "player_id="))
 			}
-
 		}
+	} else if !os.IsNotExist(err) {
+		patcherLog.Fatal("reading config", "file", *confFile, "err", err)
 	}
 
 	var clientName string
@@ -85,20 +74,61 @@ func main() {
 	}
 
 	if err := downloadClient(clientName); err != nil {
-		logger.Fatal(err)
+		patcherLog.Fatal("downloading client", "err", err)
 	}
 
 	cwd, err := os.Getwd()
 	if err != nil {
-		logger.Fatal(err)
+		patcherLog.Fatal("finding working directory", "err", err)
+	}
+
+	// without a list the client offers just the patch server. An old list is still
+	// offered, but the client tells the player it may be out of date
+	args := []string{"--addr", *addr, "--servers", serverFile, "--protocol", *protocol}
+	if err := downloadServerList(); err != nil {
+		patcherLog.Warn("downloading server list", "err", err)
+		if _, statErr := os.Stat(serverFile); statErr == nil {
+			args = append(args, "--servers-stale", err.Error())
+		}
 	}
 
-	cmd := exec.Command(filepath.Join(cwd, clientName), "--addr", *addr, "--id", *playerID, "--protocol", *protocol)
+	// with no player ID the client asks the player to log in
+	if *playerID != "" {
+		args = append(args, "--id", *playerID)
+	}
+	cmd := exec.Command(filepath.Join(cwd, clientName), args...)
 	cmd.Stdout = out
 	cmd.Stderr = out
+	patcherLog.Info("starting client", "client", clientName, "id", *playerID)
 	if err := cmd.Run(); err != nil {
-		logger.Fatal(err)
+		patcherLog.Fatal("client exited", "err", err)
+	}
+}
+
+// downloadServerList saves the servers the patch server lists for the client to choose from
+func downloadServerList() error {
+	res, err := lxhttpclient.GetAsync(*addr, shared.ServersPath, nil)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s returned %s", shared.ServersPath, res.Status)
+	}
+	data, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+	var servers []shared.ServerInfo
+	if err := json.Unmarshal(data, &servers); err != nil {
+		return fmt.Errorf("%s returned a bad list: %v", shared.ServersPath, err)
+	}
+	// written then renamed, so a failure never leaves half a list
+	tmp := serverFile + ".tmp"
+	if err := ioutil.WriteFile(tmp, data, 0644); err != nil {
+		return err
 	}
+	return os.Rename(tmp, serverFile)
 }
 
 func downloadClient(clientName string) error {
@@ -120,10 +150,10 @@ func downloadClient(clientName string) error {
 	}
 	//we already have the right client, skip download
 	if res.StatusCode == http.StatusNoContent {
-		log.Printf("skipping download")
+		patcherLog.Info("client up to date, skipping download", "client", clientName)
 		return nil
 	}
-	log.Printf("downloading client")
+	patcherLog.Info("downloading client", "client", clientName)
 
 	clientBin, err := os.Create(clientName)
 	if err != nil {
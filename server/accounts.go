@@ -0,0 +1,488 @@
+package main
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mmogo/mmo/shared"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	maxCharacters = 5                // per account
+	tokenLifetime = time.Minute * 30 // how long a login can be used to connect
+	maxFailures   = 5                // failed logins to an account, or from an address, before more are refused...
+	failureWindow = time.Minute * 5  // ...until this long after the first of them
+)
+
+// account is a login which owns characters
+type account struct {
+	Name       string
+	Hash       []byte // bcrypt of the password
+	Characters []string
+}
+
+// session is a login, good for connecting to the account's characters until it expires
+type session struct {
+	account string
+	expires time.Time
+}
+
+// failures counts failed logins to an account or from an address
+type failures struct {
+	count int
+	since time.Time // of the first failure counted
+}
+
+// accountStore keeps a JSON file per account in a directory, and the tokens of those who
+// have logged in. Account names are case insensitive, character names are player IDs,
+// which are too
+type accountStore struct {
+	dir     string
+	players *playerStore
+
+	lock     sync.Mutex // held while changing accounts, so a name can't be taken twice
+	sessions map[string]*session
+	failures map[string]*failures // by "account "+name and "ip "+address
+	missing  []byte               // bcrypt of nothing, checked for accounts which don't exist
+	reserved map[string]bool      // lower case character names only an admin can give out, with Claim
+}
+
+func newAccountStore(dir string, players *playerStore) (*accountStore, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	missing, err := bcrypt.GenerateFromPassword([]byte{}, bcrypt.DefaultCost)
+	if err != nil {
+		return nil, err
+	}
+	return &accountStore{
+		dir:      dir,
+		players:  players,
+		sessions: make(map[string]*session),
+		failures: make(map[string]*failures),
+		reserved: make(map[string]bool),
+		missing:  missing,
+	}, nil
+}
+
+// reserve stops players creating characters with names, such as those of admins and
+// moderators, which are trusted. It must be called before the store is used
+func (a *accountStore) reserve(names []string) {
+	for _, name := range names {
+		if name != "" {
+			a.reserved[strings.ToLower(name)] = true
+		}
+	}
+}
+
+// requestError is a mistake in a request to the account endpoints, told to the player
+type requestError struct {
+	status int
+	msg    string
+}
+
+func (e *requestError) Error() string {
+	return e.msg
+}
+
+func badRequest(format string, args ...interface{}) error {
+	return &requestError{status: http.StatusBadRequest, msg: fmt.Sprintf(format, args...)}
+}
+
+var errBadLogin = &requestError{status: http.StatusUnauthorized, msg: "wrong account name or password"}
+
+var errTooManyFailures = &requestError{status: http.StatusTooManyRequests, msg: "too many failed logins, try again later"}
+
+func (a *accountStore) path(name string) string {
+	return filepath.Join(a.dir, url.PathEscape(strings.ToLower(name))+".json")
+}
+
+// load returns an account, or nil if there is none by that name. a.lock must be held
+func (a *accountStore) load(name string) (*account, error) {
+	data, err := ioutil.ReadFile(a.path(name))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var acc account
+	if err := json.Unmarshal(data, &acc); err != nil {
+		return nil, err
+	}
+	return &acc, nil
+}
+
+// save writes an account, replacing the previous file. a.lock must be held
+func (a *accountStore) save(acc *account) error {
+	data, err := json.MarshalIndent(acc, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := a.path(acc.Name) + ".tmp"
+	if err := ioutil.WriteFile(tmp, data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, a.path(acc.Name))
+}
+
+// Register creates an account with no characters
+func (a *accountStore) Register(name, password string) error {
+	if err := shared.ValidName(name); err != nil {
+		return badRequest("%v", err)
+	}
+	if len(password) < shared.MinPasswordLength {
+		return badRequest("passwords must be at least %v characters", shared.MinPasswordLength)
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	existing, err := a.load(name)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		return badRequest("the account name %s is taken", name)
+	}
+	return a.save(&account{Name: name, Hash: hash, Characters: []string{}})
+}
+
+// Login checks a password and returns a new token and the account's characters. Logins to
+// an account, or from an address, are refused for a while after too many of them fail
+func (a *accountStore) Login(name, password, ip string) (*shared.LoginResponse, error) {
+	keys := []string{"account " + strings.ToLower(name), "ip " + ip}
+	a.lock.Lock()
+	now := time.Now()
+	for _, key := range keys {
+		if f := a.failures[key]; f != nil && f.count >= maxFailures && now.Sub(f.since) < failureWindow {
+			a.lock.Unlock()
+			return nil, errTooManyFailures
+		}
+	}
+	// counted as a failure until the password is found to be right, so that guesses
+	// made at once can't all be checked before any of them is counted
+	a.attempt(keys, now)
+	acc, err := a.load(name)
+	a.lock.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	// bcrypt is slow on purpose, so it is checked without holding up everyone else. It is
+	// checked for accounts which don't exist too, so they take as long to be refused
+	hash := a.missing
+	if acc != nil {
+		hash = acc.Hash
+	}
+	if bcrypt.CompareHashAndPassword(hash, []byte(password)) != nil || acc == nil {
+		return nil, errBadLogin
+	}
+
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	delete(a.failures, keys[0])
+	if f := a.failures[keys[1]]; f != nil {
+		if f.count--; f.count <= 0 {
+			delete(a.failures, keys[1])
+		}
+	}
+	now = time.Now()
+	for token, s := range a.sessions {
+		if now.After(s.expires) {
+			delete(a.sessions, token)
+		}
+	}
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return nil, err
+	}
+	token := hex.EncodeToString(b)
+	a.sessions[token] = &session{account: acc.Name, expires: now.Add(tokenLifetime)}
+	return a.response(token, acc)
+}
+
+// attempt counts a login against each key as failed, forgetting failures from before the window. a.lock must be held
+func (a *accountStore) attempt(keys []string, now time.Time) {
+	for key, f := range a.failures {
+		if now.Sub(f.since) >= failureWindow {
+			delete(a.failures, key)
+		}
+	}
+	for _, key := range keys {
+		f := a.failures[key]
+		if f == nil {
+			f = &failures{since: now}
+			a.failures[key] = f
+		}
+		f.count++
+	}
+}
+
+// CreateCharacter adds a new character to the account a token is for, and saves
+// the character so that its name is taken
+func (a *accountStore) CreateCharacter(token, name string, appearance *shared.Appearance) (*shared.LoginResponse, error) {
+	if err := shared.ValidName(name); err != nil {
+		return nil, badRequest("%v", err)
+	}
+	if err := shared.ValidAppearance(appearance); err != nil {
+		return nil, badRequest("%v", err)
+	}
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	acc, err := a.sessionAccount(token)
+	if err != nil {
+		return nil, err
+	}
+	if len(acc.Characters) >= maxCharacters {
+		return nil, badRequest("accounts can have at most %v characters", maxCharacters)
+	}
+	if a.reserved[strings.ToLower(name)] {
+		return nil, badRequest("the character name %s is reserved", name)
+	}
+	// player IDs are case insensitive, so this is also taken if it only differs in case
+	taken, err := a.players.Exists(name)
+	if err != nil {
+		return nil, err
+	}
+	if taken {
+		return nil, badRequest("the character name %s is taken", name)
+	}
+	record, err := a.players.Load(name)
+	if err != nil {
+		return nil, err
+	}
+	record.Appearance = appearance
+	if err := a.players.Save(record); err != nil {
+		return nil, err
+	}
+	acc.Characters = append(acc.Characters, name)
+	if err := a.save(acc); err != nil {
+		return nil, err
+	}
+	return a.response(token, acc)
+}
+
+// Claim gives a character to an account. Characters played before there were accounts
+// have no owner, so an admin gives them to their players' accounts this way. It is also
+// how characters with reserved names are made, being created if they have never played
+func (a *accountStore) Claim(name, id string) error {
+	if id == "" {
+		return fmt.Errorf("no character given")
+	}
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	acc, err := a.load(name)
+	if err != nil {
+		return err
+	}
+	if acc == nil {
+		return fmt.Errorf("there is no account %s", name)
+	}
+	if len(acc.Characters) >= maxCharacters {
+		return fmt.Errorf("accounts can have at most %v characters", maxCharacters)
+	}
+	owner, err := a.owner(id)
+	if err != nil {
+		return err
+	}
+	if owner != "" {
+		return fmt.Errorf("%s already belongs to the account %s", id, owner)
+	}
+	exists, err := a.players.Exists(id)
+	if err != nil {
+		return err
+	}
+	record, err := a.players.Load(id)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		if err := a.players.Save(record); err != nil {
+			return err
+		}
+	}
+	// listed as it is known in the game, whatever case it was claimed in
+	acc.Characters = append(acc.Characters, record.ID)
+	return a.save(acc)
+}
+
+// owner returns the name of the account a character belongs to, or "" if it has none.
+// Every account is read, which is fine for the rare claim. a.lock must be held
+func (a *accountStore) owner(id string) (string, error) {
+	files, err := ioutil.ReadDir(a.dir)
+	if err != nil {
+		return "", err
+	}
+	for _, f := range files {
+		if filepath.Ext(f.Name()) != ".json" {
+			continue
+		}
+		data, err := ioutil.ReadFile(filepath.Join(a.dir, f.Name()))
+		if err != nil {
+			return "", err
+		}
+		var acc account
+		if err := json.Unmarshal(data, &acc); err != nil {
+			return "", fmt.Errorf("reading %s: %v", f.Name(), err)
+		}
+		for _, c := range acc.Characters {
+			if strings.EqualFold(c, id) {
+				return acc.Name, nil
+			}
+		}
+	}
+	return "", nil
+}
+
+// Owns reports whether a token is for the account a character belongs to
+func (a *accountStore) Owns(token, id string) (bool, error) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	acc, err := a.sessionAccount(token)
+	if err == errBadLogin {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	for _, c := range acc.Characters {
+		if strings.EqualFold(c, id) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// sessionAccount returns the account a token is for. a.lock must be held
+func (a *accountStore) sessionAccount(token string) (*account, error) {
+	s, ok := a.sessions[token]
+	if !ok || time.Now().After(s.expires) {
+		return nil, errBadLogin
+	}
+	acc, err := a.load(s.account)
+	if err != nil {
+		return nil, err
+	}
+	if acc == nil {
+		return nil, errBadLogin
+	}
+	return acc, nil
+}
+
+// response lists an account's characters with a token. a.lock must be held
+func (a *accountStore) response(token string, acc *account) (*shared.LoginResponse, error) {
+	res := &shared.LoginResponse{Token: token, Characters: []*shared.Character{}}
+	for _, id := range acc.Characters {
+		record, err := a.players.Load(id)
+		if err != nil {
+			return nil, err
+		}
+		c := &shared.Character{ID: id, Level: 1, Appearance: record.Appearance}
+		if record.Stats != nil {
+			c.Level = record.Stats.Level
+		}
+		res.Characters = append(res.Characters, c)
+	}
+	return res, nil
+}
+
+// serve adds the account endpoints to a mux
+func (a *accountStore) serve(mux *http.ServeMux) {
+	mux.HandleFunc(shared.RegisterPath, func(w http.ResponseWriter, req *http.Request) {
+		var in shared.AccountRequest
+		if !decodeRequest(w, req, &in) {
+			return
+		}
+		err := a.Register(in.Account, in.Password)
+		if err == nil {
+			accountLog.Info("account created", "account", in.Account, "from", req.RemoteAddr)
+		}
+		reply(w, req, struct{}{}, err)
+	})
+	mux.HandleFunc(shared.LoginPath, func(w http.ResponseWriter, req *http.Request) {
+		var in shared.AccountRequest
+		if !decodeRequest(w, req, &in) {
+			return
+		}
+		res, err := a.Login(in.Account, in.Password, requestIP(req))
+		switch err {
+		case errBadLogin:
+			accountLog.Warn("failed login", "account", in.Account, "from", req.RemoteAddr)
+		case errTooManyFailures:
+			accountLog.Warn("login refused after failures", "account", in.Account, "from", req.RemoteAddr)
+		}
+		reply(w, req, res, err)
+	})
+	mux.HandleFunc(shared.CreateCharacterPath, func(w http.ResponseWriter, req *http.Request) {
+		var in shared.CreateCharacterRequest
+		if !decodeRequest(w, req, &in) {
+			return
+		}
+		res, err := a.CreateCharacter(in.Token, in.Name, in.Appearance)
+		if err == nil {
+			accountLog.Info("character created", "character", in.Name, "from", req.RemoteAddr)
+		}
+		reply(w, req, res, err)
+	})
+}
+
+// requestIP returns the address a request came from, without the port
+func requestIP(req *http.Request) string {
+	host, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		return req.RemoteAddr
+	}
+	return host
+}
+
+// serveTLS serves the account endpoints alone over HTTPS
+func (a *accountStore) serveTLS(addr, certFile, keyFile string) error {
+	mux := http.NewServeMux()
+	a.serve(mux)
+	return http.ListenAndServeTLS(addr, certFile, keyFile, mux)
+}
+
+// decodeRequest reads the JSON body of a POST, answering the request itself if it can't
+func decodeRequest(w http.ResponseWriter, req *http.Request, v interface{}) bool {
+	if req.Method != "POST" {
+		http.Error(w, "POST only", http.StatusMethodNotAllowed)
+		return false
+	}
+	if err := json.NewDecoder(http.MaxBytesReader(w, req.Body, 4096)).Decode(v); err != nil {
+		http.Error(w, "bad request: "+err.Error(), http.StatusBadRequest)
+		return false
+	}
+	return true
+}
+
+// reply writes a response as JSON, or an error as text. The reasons for internal errors
+// are logged rather than sent
+func reply(w http.ResponseWriter, req *http.Request, v interface{}, err error) {
+	if rerr, ok := err.(*requestError); ok {
+		http.Error(w, rerr.msg, rerr.status)
+		return
+	}
+	if err != nil {
+		accountLog.Error("account request failed", "path", req.URL.Path, "from", req.RemoteAddr, "err", err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		accountLog.Warn("writing response", "path", req.URL.Path, "err", err)
+	}
+}
@@ -0,0 +1,226 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/mmogo/mmo/shared"
+)
+
+// newTestAccounts returns an account store in a temporary directory, and a function removing it
+func newTestAccounts(t *testing.T) (*accountStore, func()) {
+	dir, err := ioutil.TempDir("", "mmo-accounts")
+	if err != nil {
+		t.Fatal(err)
+	}
+	players, err := newPlayerStore(filepath.Join(dir, "data"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	accounts, err := newAccountStore(filepath.Join(dir, "accounts"), players)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return accounts, func() {
+		players.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+func TestLoginThrottling(t *testing.T) {
+	tests := []struct {
+		name string
+		ip   string // of the login with the right password, after the failures from 10.0.0.1
+		fail int
+		want error
+	}{
+		{name: "a few failures", ip: "10.0.0.1", fail: maxFailures - 1},
+		{name: "too many failures", ip: "10.0.0.1", fail: maxFailures, want: errTooManyFailures},
+		{name: "too many failures from elsewhere", ip: "10.0.0.2", fail: maxFailures, want: errTooManyFailures},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			accounts, done := newTestAccounts(t)
+			defer done()
+			if err := accounts.Register("alice", "secret1"); err != nil {
+				t.Fatal(err)
+			}
+			for i := 0; i < test.fail; i++ {
+				if _, err := accounts.Login("Alice", "wrong", "10.0.0.1"); err != errBadLogin {
+					t.Fatalf("failure %v was %v, want %v", i+1, err, errBadLogin)
+				}
+			}
+			if _, err := accounts.Login("alice", "secret1", test.ip); err != test.want {
+				t.Errorf("login was %v, want %v", err, test.want)
+			}
+		})
+	}
+}
+
+func TestLoginThrottlingByAddress(t *testing.T) {
+	accounts, done := newTestAccounts(t)
+	defer done()
+	if err := accounts.Register("alice", "secret1"); err != nil {
+		t.Fatal(err)
+	}
+	// guessing at many accounts from one address
+	for i := 0; i < maxFailures; i++ {
+		accounts.Login("nobody", "wrong", "10.0.0.1")
+	}
+	if _, err := accounts.Login("alice", "secret1", "10.0.0.1"); err != errTooManyFailures {
+		t.Errorf("login from the guessing address was %v, want %v", err, errTooManyFailures)
+	}
+	if _, err := accounts.Login("alice", "secret1", "10.0.0.2"); err != nil {
+		t.Errorf("login from another address was %v", err)
+	}
+
+	// failures are forgotten once the window has passed
+	for _, f := range accounts.failures {
+		f.since = f.since.Add(-failureWindow)
+	}
+	if _, err := accounts.Login("alice", "secret1", "10.0.0.1"); err != nil {
+		t.Errorf("login after the window was %v", err)
+	}
+}
+
+func TestLoginUnknownAccount(t *testing.T) {
+	accounts, done := newTestAccounts(t)
+	defer done()
+	// the empty password is what the stand in hash for missing accounts is made from
+	for _, password := range []string{"", "secret1"} {
+		if _, err := accounts.Login("nobody", password, "10.0.0.1"); err != errBadLogin {
+			t.Errorf("login to a missing account with %q was %v, want %v", password, err, errBadLogin)
+		}
+	}
+}
+
+func TestLoginThrottlingAtOnce(t *testing.T) {
+	accounts, done := newTestAccounts(t)
+	defer done()
+	if err := accounts.Register("alice", "secret1"); err != nil {
+		t.Fatal(err)
+	}
+	// guesses made together are counted before any password is checked
+	guesses := 4 * maxFailures
+	errs := make(chan error, guesses)
+	var wg sync.WaitGroup
+	for i := 0; i < guesses; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := accounts.Login("alice", "wrong", "10.0.0.1")
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	checked := 0
+	for err := range errs {
+		switch err {
+		case errBadLogin:
+			checked++
+		case errTooManyFailures:
+		default:
+			t.Errorf("guess was %v", err)
+		}
+	}
+	if checked > maxFailures {
+		t.Errorf("%v guesses were checked, want at most %v", checked, maxFailures)
+	}
+
+	// a good login only gives back its own attempt
+	accounts, done = newTestAccounts(t)
+	defer done()
+	if err := accounts.Register("alice", "secret1"); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < maxFailures-1; i++ {
+		accounts.Login("nobody", "wrong", "10.0.0.1")
+	}
+	if _, err := accounts.Login("alice", "secret1", "10.0.0.1"); err != nil {
+		t.Fatalf("login was %v", err)
+	}
+	if _, err := accounts.Login("nobody", "wrong", "10.0.0.1"); err != errBadLogin {
+		t.Errorf("last guess allowed was %v, want %v", err, errBadLogin)
+	}
+	if _, err := accounts.Login("alice", "secret1", "10.0.0.1"); err != errTooManyFailures {
+		t.Errorf("login after too many guesses was %v, want %v", err, errTooManyFailures)
+	}
+}
+
+func TestCharacterNamesIgnoreCase(t *testing.T) {
+	accounts, done := newTestAccounts(t)
+	defer done()
+	if err := accounts.Register("alice", "secret1"); err != nil {
+		t.Fatal(err)
+	}
+	res, err := accounts.Login("alice", "secret1", "10.0.0.1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	appearance := &shared.Appearance{Color: shared.CharacterColors[0]}
+	if _, err := accounts.CreateCharacter(res.Token, "Bob", appearance); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"Bob", "bob", "BOB"} {
+		if _, err := accounts.CreateCharacter(res.Token, name, appearance); err == nil {
+			t.Errorf("created %s as well as Bob", name)
+		}
+		if owns, err := accounts.Owns(res.Token, name); err != nil || !owns {
+			t.Errorf("alice owns %s %v, %v", name, owns, err)
+		}
+	}
+	record, err := accounts.players.Load("BOB")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if record.ID != "Bob" {
+		t.Errorf("BOB loaded as %q, want Bob as he was created", record.ID)
+	}
+}
+
+func TestClaim(t *testing.T) {
+	accounts, done := newTestAccounts(t)
+	defer done()
+	accounts.reserve([]string{"Admin"})
+	for _, name := range []string{"alice", "bob"} {
+		if err := accounts.Register(name, "secret1"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// played before there were accounts
+	old, err := accounts.players.Load("oldtimer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := accounts.players.Save(old); err != nil {
+		t.Fatal(err)
+	}
+	res, err := accounts.Login("alice", "secret1", "10.0.0.1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, name := range []string{"oldtimer", "admin", "ADMIN"} {
+		if _, err := accounts.CreateCharacter(res.Token, name, &shared.Appearance{Color: shared.CharacterColors[0]}); err == nil {
+			t.Errorf("created a character named %s", name)
+		}
+	}
+	for _, id := range []string{"oldtimer", "Admin"} {
+		if err := accounts.Claim("alice", id); err != nil {
+			t.Errorf("claiming %s: %v", id, err)
+		}
+		if owns, err := accounts.Owns(res.Token, id); err != nil || !owns {
+			t.Errorf("alice owns %s %v, %v after claiming it", id, owns, err)
+		}
+	}
+	if err := accounts.Claim("bob", "oldtimer"); err == nil {
+		t.Error("bob claimed alice's character")
+	}
+	if err := accounts.Claim("nobody", "someone"); err == nil {
+		t.Error("claimed for an account which doesn't exist")
+	}
+}
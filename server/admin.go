@@ -0,0 +1,383 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/faiface/pixel"
+	"github.com/mmogo/mmo/shared"
+	"github.com/mmogo/mmo/shared/logging"
+)
+
+const adminHelp = `commands:
+  players                      list connected players
+  kick <id> [reason]           disconnect a player
+  ban <id|ip|cidr> [duration] [reason]
+                               disconnect and refuse a player, address or range,
+                               for a duration like 30m, 12h or 7d, or permanently
+  unban <id|ip|cidr>
+  bans                         list bans in force
+  reloadbans                   reread the ban file after editing it
+  teleport <id> <x> <y>        move a player to a point
+  teleport <id> <other id>     move a player to another player
+  broadcast <message>          tell every player something
+  tickrate <ticks per second>  change how often the world updates
+  log [levels]                 show or change log levels, e.g. log net=debug,tick=off
+  reload                       reread the config and ban files, as SIGHUP does
+  save                         save everyone online now, rather than at the next SaveInterval
+  claim <account> <id>         give a character to an account, such as one played before
+                               there were accounts or one with a reserved admin name.
+                               console only`
+
+// inGameSource starts the source of admin commands typed into chat, followed by the admin's ID
+const inGameSource = "player "
+
+// adminRequest is an admin command waiting to be run by the game loop
+type adminRequest struct {
+	source string
+	line   string
+	reply  chan string
+}
+
+// adminCommand runs a command in the game loop and waits for its result.
+// Source names where the command came from in the audit log
+func (s *mmoServer) adminCommand(source, line string) string {
+	req := &adminRequest{source: source, line: line, reply: make(chan string, 1)}
+	s.adminRequests <- req
+	return <-req.reply
+}
+
+// runAdminRequests runs the admin commands sent since the last tick
+func (s *mmoServer) runAdminRequests() {
+	for {
+		select {
+		case req := <-s.adminRequests:
+			s.recordEvent(&event{Admin: &adminEvent{Source: req.source, Line: req.line}})
+			req.reply <- s.runAdminCommand(req.source, req.line)
+		default:
+			return
+		}
+	}
+}
+
+// serveConsole reads admin commands a line at a time and writes back their results
+func (s *mmoServer) serveConsole(r io.Reader, w io.Writer, source string) {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		fmt.Fprintln(w, s.adminCommand(source, line))
+	}
+}
+
+// serveAdminSocket accepts console connections on a unix socket
+func (s *mmoServer) serveAdminSocket(path string) error {
+	// a socket left behind by an earlier run would stop us listening
+	os.Remove(path)
+	l, err := net.Listen("unix", path)
+	if err != nil {
+		return err
+	}
+	// the console can do anything, so only the server's user may connect
+	if err := os.Chmod(path, 0600); err != nil {
+		l.Close()
+		return err
+	}
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			return err
+		}
+		go func() {
+			defer conn.Close()
+			s.serveConsole(conn, conn, "socket")
+		}()
+	}
+}
+
+// runAdminCommand executes an admin command in the game loop and records it in the audit log
+func (s *mmoServer) runAdminCommand(source, line string) string {
+	result := s.execAdminCommand(source, line)
+	serverLog.Info("admin command", "source", source, "command", line)
+	if s.audit != nil {
+		s.audit.Printf("%s: %s: %s", source, line, strings.Replace(result, "\n", "; ", -1))
+	}
+	return result
+}
+
+func (s *mmoServer) execAdminCommand(source, line string) string {
+	fields := strings.Fields(line)
+	if len(fields) == 0 {
+		return adminHelp
+	}
+	command, args := strings.ToLower(fields[0]), fields[1:]
+	rest := func(from int) string {
+		if len(args) <= from {
+			return ""
+		}
+		return strings.Join(args[from:], " ")
+	}
+
+	switch command {
+	case "players", "who":
+		// round trip times are measured outside the game loop, so they can't be replayed
+		// and are only shown outside the game, where replies aren't recorded
+		return s.listPlayers(!strings.HasPrefix(source, inGameSource))
+	case "kick":
+		if len(args) < 1 {
+			return "usage: kick <id> [reason]"
+		}
+		reason := rest(1)
+		if reason == "" {
+			reason = "kicked by an admin"
+		}
+		if !s.kick(args[0], reason) {
+			return fmt.Sprintf("no player %q", args[0])
+		}
+		return "kicked " + args[0]
+	case "ban":
+		if len(args) < 1 {
+			return "usage: ban <id|ip|cidr> [duration] [reason]"
+		}
+		b := &ban{Target: args[0], Created: s.now, By: source}
+		reasonFrom := 1
+		if len(args) > 1 {
+			d, err := parseBanDuration(args[1])
+			switch {
+			case err == nil:
+				if d > 0 {
+					b.Expires = b.Created.Add(d)
+				}
+				reasonFrom = 2
+			case args[1][0] >= '0' && args[1][0] <= '9':
+				// a mistyped duration would otherwise make a permanent ban
+				return fmt.Sprintf("%v, use e.g. 30m, 12h, 7d or perm\nusage: ban <id|ip|cidr> [duration] [reason]", err)
+			}
+		}
+		b.Reason = rest(reasonFrom)
+		if b.Reason == "" {
+			b.Reason = "banned by an admin"
+		}
+		if err := s.bans.Add(b); err != nil {
+			return "ban failed: " + err.Error()
+		}
+		kicked := s.kickMatching(b)
+		return fmt.Sprintf("%s %s, kicked %v player(s)", args[0], b.Error(), kicked)
+	case "unban":
+		if len(args) < 1 {
+			return "usage: unban <id|ip|cidr>"
+		}
+		removed, err := s.bans.Remove(args[0])
+		if err != nil {
+			return "unban failed: " + err.Error()
+		}
+		if !removed {
+			return args[0] + " is not banned"
+		}
+		return "unbanned " + args[0]
+	case "bans":
+		bans := s.bans.List(s.now)
+		lines := []string{fmt.Sprintf("%v bans", len(bans))}
+		for _, b := range bans {
+			lines = append(lines, fmt.Sprintf("  %s %s (by %s)", b.Target, b.Error(), b.By))
+		}
+		return strings.Join(lines, "\n")
+	case "reloadbans":
+		if err := s.reloadBans(); err != nil {
+			return "reload failed: " + err.Error()
+		}
+		kicked := 0
+		for _, b := range s.bans.List(s.now) {
+			kicked += s.kickMatching(b)
+		}
+		return fmt.Sprintf("reloaded bans, kicked %v player(s)", kicked)
+	case "teleport", "tp":
+		return s.teleport(args)
+	case "broadcast":
+		if len(args) < 1 {
+			return "usage: broadcast <message>"
+		}
+		s.queueChat(s.playerIDs(), &shared.PlayerSpoke{Text: "[server] " + rest(0), Channel: shared.CHAT_SYSTEM})
+		return "sent"
+	case "tickrate":
+		if len(args) < 1 {
+			return fmt.Sprintf("ticking %.0f times per second", 1/s.tickTime)
+		}
+		rate, err := strconv.Atoi(args[0])
+		if err != nil || rate < 1 || rate > 120 {
+			return "tick rate must be a whole number from 1 to 120"
+		}
+		s.tickTime = 1.0 / float64(rate)
+		return fmt.Sprintf("ticking %v times per second", rate)
+	case "log":
+		if len(args) > 0 {
+			if err := logging.Adjust(rest(0)); err != nil {
+				return err.Error()
+			}
+		}
+		return "log levels: " + logging.Levels()
+	case "reload":
+		return s.reload()
+	case "save":
+		return fmt.Sprintf("saved %v player(s)", s.saveOnline())
+	case "claim":
+		if strings.HasPrefix(source, inGameSource) {
+			return "claim can only be used from the console"
+		}
+		if s.accounts == nil {
+			return "there are no accounts here"
+		}
+		if len(args) != 2 {
+			return "usage: claim <account> <id>"
+		}
+		if err := s.accounts.Claim(args[0], args[1]); err != nil {
+			return "claim failed: " + err.Error()
+		}
+		return fmt.Sprintf("gave %s to the account %s", args[1], args[0])
+	case "help":
+		return adminHelp
+	default:
+		return fmt.Sprintf("unknown command %q\n%s", command, adminHelp)
+	}
+}
+
+func (s *mmoServer) listPlayers(showRTT bool) string {
+	s.playersLock.RLock()
+	defer s.playersLock.RUnlock()
+	ids := sortedKeys(s.players)
+	lines := []string{fmt.Sprintf("%v players", len(ids))}
+	for _, id := range ids {
+		player := s.players[id]
+		line := fmt.Sprintf("  %s  %s", id, player.Conn.RemoteAddr())
+		if showRTT {
+			rtt := time.Duration(atomic.LoadInt64(&player.RTT))
+			line += fmt.Sprintf("  rtt %v", rtt/time.Millisecond*time.Millisecond)
+		}
+		lines = append(lines, line+fmt.Sprintf("  level %v  at %.0f,%.0f", player.Stats.Level, player.Position.X, player.Position.Y))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// kick tells a player why and disconnects them. Their session ends like any other disconnect
+func (s *mmoServer) kick(name, reason string) bool {
+	id, ok := s.onlineID(name)
+	if !ok {
+		return false
+	}
+	s.playersLock.RLock()
+	player, ok := s.players[id]
+	s.playersLock.RUnlock()
+	if !ok {
+		return false
+	}
+	conn := player.Conn
+	s.queueUpdate(func() error {
+		s.sendError(conn, shared.FatalErr(fmt.Errorf("kicked: %s", reason)))
+		conn.Close()
+		return nil
+	})
+	return true
+}
+
+// kickMatching kicks every connected player covered by a ban, returning how many
+func (s *mmoServer) kickMatching(b *ban) int {
+	s.playersLock.RLock()
+	matching := []string{}
+	for _, id := range sortedKeys(s.players) {
+		if b.matches(id, remoteIP(s.players[id].Conn)) {
+			matching = append(matching, id)
+		}
+	}
+	s.playersLock.RUnlock()
+	for _, id := range matching {
+		s.kick(id, b.Error())
+	}
+	return len(matching)
+}
+
+// teleport handles teleport <id> <x> <y> and teleport <id> <other id>
+func (s *mmoServer) teleport(args []string) string {
+	if len(args) != 2 && len(args) != 3 {
+		return "usage: teleport <id> <x> <y> or teleport <id> <other id>"
+	}
+	id, ok := s.onlineID(args[0])
+	if !ok {
+		return fmt.Sprintf("no player %q", args[0])
+	}
+	otherID, _ := s.onlineID(args[1])
+	s.playersLock.RLock()
+	defer s.playersLock.RUnlock()
+	player, ok := s.players[id]
+	if !ok {
+		return fmt.Sprintf("no player %q", args[0])
+	}
+	var to pixel.Vec
+	if len(args) == 2 {
+		other, ok := s.players[otherID]
+		if !ok {
+			return fmt.Sprintf("no player %q", args[1])
+		}
+		to = other.Position
+	} else {
+		x, errX := strconv.ParseFloat(args[1], 64)
+		y, errY := strconv.ParseFloat(args[2], 64)
+		if errX != nil || errY != nil {
+			return "x and y must be numbers"
+		}
+		to = pixel.V(x, y)
+	}
+	s.interruptCast(id, player)
+	player.Position = to
+	moved := player.Entity.Copy()
+	s.queueUpdate(func() error {
+		return s.broadcastEntityMoved(moved, s.now)
+	})
+	return fmt.Sprintf("teleported %s to %.0f,%.0f", id, to.X, to.Y)
+}
+
+// handleAdminChat runs an admin command typed into chat by a player, e.g. /kick name
+func (s *mmoServer) handleAdminChat(id, command, args string) {
+	if !s.admins[strings.ToLower(id)] {
+		s.queueSystemChat(id, "only admins can /"+command)
+		return
+	}
+	for _, line := range strings.Split(s.runAdminCommand(inGameSource+id, command+" "+args), "\n") {
+		s.queueSystemChat(id, line)
+	}
+}
+
+// pingPlayers asks every player for a pong every pingInterval to keep their round trip time current
+func (s *mmoServer) pingPlayers() {
+	now := s.now
+	if now.Sub(s.lastPing) < pingInterval {
+		return
+	}
+	s.lastPing = now
+	for _, id := range s.playerIDs() {
+		id := id
+		s.queueUpdate(func() error {
+			return s.send(id, &shared.Message{
+				Update: &shared.Update{Ping: &shared.Ping{Sent: now}},
+			})
+		})
+	}
+}
+
+// remoteIP returns the address a connection comes from without its port
+func remoteIP(conn net.Conn) string {
+	addr := conn.RemoteAddr().String()
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return addr
+	}
+	return host
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestBanCommand(t *testing.T) {
+	tests := []struct {
+		line    string
+		banned  bool
+		expires time.Duration // after the ban is made, 0 for permanent
+		reason  string
+	}{
+		{line: "ban bob", banned: true, reason: "banned by an admin"},
+		{line: "ban bob 7d cheating", banned: true, expires: 7 * 24 * time.Hour, reason: "cheating"},
+		{line: "ban bob 30m", banned: true, expires: 30 * time.Minute, reason: "banned by an admin"},
+		{line: "ban bob perm cheating", banned: true, reason: "cheating"},
+		{line: "ban bob cheating again", banned: true, reason: "cheating again"},
+		{line: "ban bob 7dd cheating"},
+		{line: "ban bob 30x"},
+	}
+	for _, test := range tests {
+		t.Run(test.line, func(t *testing.T) {
+			s, _, done := newTestServer(t)
+			defer done()
+			result := s.runAdminCommand("console", test.line)
+			if len(s.bans.bans) == 0 {
+				if test.banned {
+					t.Fatalf("not banned: %s", result)
+				}
+				if !strings.Contains(result, "usage") {
+					t.Errorf("refused with %q, want the usage", result)
+				}
+				return
+			}
+			if !test.banned {
+				t.Fatalf("banned: %s", result)
+			}
+			b := s.bans.bans[0]
+			if b.Reason != test.reason {
+				t.Errorf("banned for %q, want %q", b.Reason, test.reason)
+			}
+			if expires := b.Expires.Sub(b.Created); test.expires == 0 && !b.Expires.IsZero() || test.expires != 0 && expires != test.expires {
+				t.Errorf("ban expires %v, want after %v", b.Expires, test.expires)
+			}
+		})
+	}
+}
@@ -0,0 +1,196 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ban refuses connections from a player ID, an IP or a CIDR range of IPs
+type ban struct {
+	Target  string // player ID, IP or CIDR range
+	Reason  string
+	Expires time.Time // zero for a permanent ban
+	Created time.Time
+	By      string // who made the ban
+}
+
+func (b *ban) expired(now time.Time) bool {
+	return !b.Expires.IsZero() && !now.Before(b.Expires)
+}
+
+// matches reports whether the ban covers a player connecting from ip
+func (b *ban) matches(id, ip string) bool {
+	// player IDs are case insensitive
+	if strings.EqualFold(b.Target, id) || b.Target == ip {
+		return true
+	}
+	_, cidr, err := net.ParseCIDR(b.Target)
+	if err != nil {
+		return false
+	}
+	addr := net.ParseIP(ip)
+	return addr != nil && cidr.Contains(addr)
+}
+
+// Error is the message a banned player is refused with
+func (b *ban) Error() string {
+	if b.Expires.IsZero() {
+		return fmt.Sprintf("banned permanently: %s", b.Reason)
+	}
+	return fmt.Sprintf("banned until %s: %s", b.Expires.Format(time.RFC1123), b.Reason)
+}
+
+// banList is the set of bans, kept in a JSON file so they last across restarts
+type banList struct {
+	lock sync.Mutex
+	path string
+	bans []*ban
+}
+
+// loadBanList reads the ban list from path. A missing file is an empty list
+func loadBanList(path string) (*banList, error) {
+	l := &banList{path: path}
+	if err := l.Reload(); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// Reload replaces the bans with those in the file, picking up edits made while running
+func (l *banList) Reload() error {
+	bans, err := l.read()
+	if err != nil {
+		return err
+	}
+	l.replace(bans)
+	return nil
+}
+
+// read returns the bans in the file
+func (l *banList) read() ([]*ban, error) {
+	data, err := ioutil.ReadFile(l.path)
+	if os.IsNotExist(err) {
+		data, err = []byte("[]"), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var bans []*ban
+	if err := json.Unmarshal(data, &bans); err != nil {
+		return nil, fmt.Errorf("parsing %s: %v", l.path, err)
+	}
+	return bans, nil
+}
+
+// replace swaps the bans for a new set without saving them
+func (l *banList) replace(bans []*ban) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.bans = bans
+}
+
+// save writes the bans to the file, dropping any which have expired. The lock must be held
+func (l *banList) save() error {
+	now := time.Now()
+	current := []*ban{}
+	for _, b := range l.bans {
+		if !b.expired(now) {
+			current = append(current, b)
+		}
+	}
+	l.bans = current
+	data, err := json.MarshalIndent(l.bans, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := l.path + ".tmp"
+	if err := ioutil.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, l.path)
+}
+
+// Add bans a target, replacing any earlier ban of it
+func (l *banList) Add(b *ban) error {
+	if b.Target == "" {
+		return fmt.Errorf("nothing to ban")
+	}
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.remove(b.Target)
+	l.bans = append(l.bans, b)
+	return l.save()
+}
+
+// Remove lifts the ban of a target, returning false if it was not banned
+func (l *banList) Remove(target string) (bool, error) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if !l.remove(target) {
+		return false, nil
+	}
+	return true, l.save()
+}
+
+func (l *banList) remove(target string) bool {
+	for i, b := range l.bans {
+		if strings.EqualFold(b.Target, target) {
+			l.bans = append(l.bans[:i], l.bans[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// parseBanDuration parses a ban length such as 30m, 12h or 7d. Zero means permanent
+func parseBanDuration(s string) (time.Duration, error) {
+	if s == "perm" || s == "permanent" {
+		return 0, nil
+	}
+	if strings.HasSuffix(s, "d") {
+		days, err := strconv.Atoi(strings.TrimSuffix(s, "d"))
+		if err != nil || days <= 0 {
+			return 0, fmt.Errorf("bad duration %q", s)
+		}
+		return time.Duration(days) * 24 * time.Hour, nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("bad duration %q", s)
+	}
+	return d, nil
+}
+
+// Check returns the ban covering a player connecting from ip, or nil if they may connect
+func (l *banList) Check(id, ip string) *ban {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	now := time.Now()
+	for _, b := range l.bans {
+		if !b.expired(now) && b.matches(id, ip) {
+			return b
+		}
+	}
+	return nil
+}
+
+// List returns the bans in force at a time
+func (l *banList) List(now time.Time) []*ban {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	current := []*ban{}
+	for _, b := range l.bans {
+		if !b.expired(now) {
+			c := *b
+			current = append(current, &c)
+		}
+	}
+	return current
+}
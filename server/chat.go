@@ -0,0 +1,266 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/faiface/pixel"
+	"github.com/ilackarms/pkg/errors"
+	"github.com/mmogo/mmo/shared"
+)
+
+const chatHelp = "commands: /say, /shout, /w name message, /party message, /invite name, /accept, /leave, /who"
+
+// party is a group of players sharing the party channel
+type party struct {
+	members map[string]bool
+}
+
+// parseChat splits a chat line into its command and the rest of the line.
+// Lines which are not commands have an empty command
+func parseChat(text string) (string, string) {
+	text = strings.TrimSpace(text)
+	if !strings.HasPrefix(text, "/") {
+		return "", text
+	}
+	fields := strings.SplitN(text[1:], " ", 2)
+	command := strings.ToLower(fields[0])
+	if len(fields) < 2 {
+		return command, ""
+	}
+	return command, strings.TrimSpace(fields[1])
+}
+
+func (s *mmoServer) handleSpeakRequest(id string, req *shared.SpeakRequest) error {
+	s.playersLock.RLock()
+	player := s.players[id]
+	s.playersLock.RUnlock()
+	if player == nil {
+		return errors.New("requesting player "+id+" is nil??", nil)
+	}
+
+	if limit := s.cfg().MaxChatLength; utf8.RuneCountInString(req.Text) > limit {
+		s.queueSystemChat(id, fmt.Sprintf("message too long, the limit is %v characters", limit))
+		return nil
+	}
+	if !s.allowChat(id, s.now) {
+		s.queueSystemChat(id, "you are sending messages too quickly")
+		return nil
+	}
+
+	command, args := parseChat(req.Text)
+	chatLog.Debug("chat", "player", id, "command", command, "text", args)
+	switch command {
+	case "", "say", "s":
+		text, ok := s.moderate(id, args)
+		if !ok || text == "" {
+			return nil
+		}
+		listeners := s.playersNear(player.Position, s.cfg().SayRadius)
+		s.queueChat(listeners, &shared.PlayerSpoke{ID: id, Text: text, Channel: shared.CHAT_SAY})
+	case "shout", "y":
+		text, ok := s.moderate(id, args)
+		if !ok || text == "" {
+			return nil
+		}
+		s.queueChat(s.playerIDs(), &shared.PlayerSpoke{ID: id, Text: text, Channel: shared.CHAT_SHOUT})
+	case "w", "whisper", "tell":
+		fields := strings.SplitN(args, " ", 2)
+		if len(fields) < 2 || fields[1] == "" {
+			s.queueSystemChat(id, "usage: /w name message")
+			return nil
+		}
+		to, online := s.onlineID(fields[0])
+		if !online {
+			s.queueSystemChat(id, fmt.Sprintf("no player named %q is online", fields[0]))
+			return nil
+		}
+		text, ok := s.moderate(id, fields[1])
+		if !ok {
+			return nil
+		}
+		// the sender sees their own whisper, once even if it was to themselves
+		listeners := []string{id}
+		if to != id {
+			listeners = append(listeners, to)
+		}
+		s.queueChat(listeners, &shared.PlayerSpoke{ID: id, Text: text, Channel: shared.CHAT_WHISPER, To: to})
+	case "party", "p":
+		members := s.partyMembers(id)
+		if members == nil {
+			s.queueSystemChat(id, "you are not in a party, /invite someone first")
+			return nil
+		}
+		text, ok := s.moderate(id, args)
+		if !ok || text == "" {
+			return nil
+		}
+		s.queueChat(members, &shared.PlayerSpoke{ID: id, Text: text, Channel: shared.CHAT_PARTY})
+	case "invite":
+		s.inviteToParty(id, args)
+	case "accept":
+		s.acceptPartyInvite(id)
+	case "leave":
+		if s.partyMembers(id) == nil {
+			s.queueSystemChat(id, "you are not in a party")
+			return nil
+		}
+		s.leaveParty(id)
+	case "who":
+		ids := s.playerIDs()
+		s.queueSystemChat(id, fmt.Sprintf("%v online: %s", len(ids), strings.Join(ids, ", ")))
+	case "mute", "unmute":
+		s.handleMuteCommand(id, command, args)
+	case "players", "kick", "ban", "unban", "bans", "reloadbans", "teleport", "tp", "broadcast", "tickrate", "log", "reload", "save":
+		s.handleAdminChat(id, command, args)
+	case "help":
+		s.queueSystemChat(id, chatHelp)
+	default:
+		s.queueSystemChat(id, fmt.Sprintf("unknown command /%s, %s", command, chatHelp))
+	}
+	return nil
+}
+
+// queueChat sends a chat message to each listener at the end of the tick
+func (s *mmoServer) queueChat(listeners []string, spoke *shared.PlayerSpoke) {
+	s.queueUpdate(func() error {
+		msg := &shared.Message{Update: &shared.Update{PlayerSpoke: spoke}}
+		for _, id := range listeners {
+			if err := s.send(id, msg); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// queueSystemChat tells a single player something in their chat log
+func (s *mmoServer) queueSystemChat(id, text string) {
+	s.queueChat([]string{id}, &shared.PlayerSpoke{Text: text, Channel: shared.CHAT_SYSTEM})
+}
+
+func (s *mmoServer) online(id string) bool {
+	_, ok := s.onlineID(id)
+	return ok
+}
+
+// onlineID returns the ID of the player online by a name given in any case
+func (s *mmoServer) onlineID(name string) (string, bool) {
+	s.playersLock.RLock()
+	defer s.playersLock.RUnlock()
+	if _, ok := s.players[name]; ok {
+		return name, true
+	}
+	for id := range s.players {
+		if strings.EqualFold(id, name) {
+			return id, true
+		}
+	}
+	return "", false
+}
+
+// playerIDs returns the IDs of the players online, in order
+func (s *mmoServer) playerIDs() []string {
+	s.playersLock.RLock()
+	defer s.playersLock.RUnlock()
+	return sortedKeys(s.players)
+}
+
+// playersNear returns the IDs of the players within radius of a point
+func (s *mmoServer) playersNear(pos pixel.Vec, radius float64) []string {
+	s.playersLock.RLock()
+	defer s.playersLock.RUnlock()
+	ids := []string{}
+	for _, id := range sortedKeys(s.players) {
+		if s.players[id].Position.Sub(pos).Len() <= radius {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// partyMembers returns everyone in the player's party, including them, or nil if they are not in one
+func (s *mmoServer) partyMembers(id string) []string {
+	s.partiesLock.Lock()
+	defer s.partiesLock.Unlock()
+	p, ok := s.parties[id]
+	if !ok {
+		return nil
+	}
+	return sortedKeys(p.members)
+}
+
+func (s *mmoServer) inviteToParty(id, name string) {
+	to, online := s.onlineID(name)
+	switch {
+	case name == "":
+		s.queueSystemChat(id, "usage: /invite name")
+		return
+	case to == id:
+		s.queueSystemChat(id, "you cannot invite yourself")
+		return
+	case !online:
+		s.queueSystemChat(id, fmt.Sprintf("no player named %q is online", name))
+		return
+	}
+	s.partiesLock.Lock()
+	_, inParty := s.parties[to]
+	if !inParty {
+		s.partyInvites[to] = id
+	}
+	s.partiesLock.Unlock()
+	if inParty {
+		s.queueSystemChat(id, to+" is already in a party")
+		return
+	}
+	s.queueSystemChat(id, "invited "+to+" to your party")
+	s.queueSystemChat(to, id+" invited you to their party, type /accept to join")
+}
+
+func (s *mmoServer) acceptPartyInvite(id string) {
+	s.partiesLock.Lock()
+	inviter, invited := s.partyInvites[id]
+	s.partiesLock.Unlock()
+	if !invited || !s.online(inviter) {
+		s.queueSystemChat(id, "you have no party invite")
+		return
+	}
+	s.leaveParty(id)
+
+	s.partiesLock.Lock()
+	p, ok := s.parties[inviter]
+	if !ok {
+		p = &party{members: map[string]bool{inviter: true}}
+		s.parties[inviter] = p
+	}
+	p.members[id] = true
+	s.parties[id] = p
+	s.partiesLock.Unlock()
+
+	s.queueChat(s.partyMembers(id), &shared.PlayerSpoke{Text: id + " joined the party", Channel: shared.CHAT_PARTY})
+}
+
+// leaveParty removes a player from their party and forgets any invite they had.
+// A party left with one member is disbanded
+func (s *mmoServer) leaveParty(id string) {
+	s.partiesLock.Lock()
+	delete(s.partyInvites, id)
+	p, ok := s.parties[id]
+	if !ok {
+		s.partiesLock.Unlock()
+		return
+	}
+	delete(p.members, id)
+	delete(s.parties, id)
+	remaining := sortedKeys(p.members)
+	if len(remaining) < 2 {
+		for _, member := range remaining {
+			delete(s.parties, member)
+		}
+	}
+	s.partiesLock.Unlock()
+
+	s.queueSystemChat(id, "you left the party")
+	s.queueChat(remaining, &shared.PlayerSpoke{Text: id + " left the party", Channel: shared.CHAT_PARTY})
+}
@@ -0,0 +1,181 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"reflect"
+	"time"
+
+	"github.com/mmogo/mmo/shared"
+	"github.com/mmogo/mmo/shared/logging"
+)
+
+// config holds the server settings and gameplay tunables. It is loaded from JSON, e.g.
+//
+//	{"TicksPerSecond": 20, "MoveSpeed": 3, "Clients": ["client-linux-amd64"]}
+//
+// Fields left out keep their defaults. Sending the server SIGHUP rereads the file;
+// everything except Clients takes effect straight away
+type config struct {
+	TicksPerSecond      int
+	MessagePerTickLimit int      // requests queued per player before reading from them pauses
+	MoveSpeed           float64  // units per move request
+	Clients             []string // client binaries served to the patcher, needs a restart
+
+	ProjectileSpeed     float64 // units per second
+	ProjectileRange     float64
+	ProjectileHitRadius float64
+	ProjectileDamage    float64 // before the shooter's strength is added
+	ShootCooldown       float64 // seconds between shots
+	ManaRegen           float64 // per second
+	RespawnTime         float64 // seconds a dead player waits to respawn
+	SaveInterval        float64 // seconds between saves of everyone online
+
+	SayRadius     float64 // how far /say carries
+	MaxChatLength int     // characters
+	ChatBurst     float64 // messages a player can send at once
+	ChatRefill    float64 // messages per second
+	MuteMinutes   float64 // length of a mute when none is given
+
+	Log string // log levels as for -log, unchanged if empty
+}
+
+var defaultConfig = config{
+	TicksPerSecond:      10,
+	MessagePerTickLimit: 60,
+	MoveSpeed:           2,
+	Clients:             []string{"client-windows-4.0-amd64.exe", "client-darwin-10.6-amd64", "client-linux-amd64"},
+
+	ProjectileSpeed:     400,
+	ProjectileRange:     600,
+	ProjectileHitRadius: 24,
+	ProjectileDamage:    10,
+	ShootCooldown:       0.5,
+	ManaRegen:           2,
+	RespawnTime:         5,
+	SaveInterval:        60,
+
+	SayRadius:     640,
+	MaxChatLength: 200,
+	ChatBurst:     5,
+	ChatRefill:    1,
+	MuteMinutes:   10,
+}
+
+// loadConfig reads and validates a config file. An empty path gives the defaults
+func loadConfig(path string) (*config, error) {
+	cfg := defaultConfig
+	cfg.Clients = append([]string{}, defaultConfig.Clients...)
+	if path == "" {
+		return &cfg, nil
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("parsing %s: %v", path, err)
+	}
+	if err := cfg.validate(); err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	return &cfg, nil
+}
+
+func (c *config) validate() error {
+	switch {
+	case c.TicksPerSecond < 1 || c.TicksPerSecond > 120:
+		return fmt.Errorf("TicksPerSecond must be from 1 to 120")
+	case c.MessagePerTickLimit < 1:
+		return fmt.Errorf("MessagePerTickLimit must be positive")
+	case c.MoveSpeed <= 0:
+		return fmt.Errorf("MoveSpeed must be positive")
+	case c.ProjectileSpeed <= 0 || c.ProjectileRange <= 0 || c.ProjectileHitRadius <= 0 || c.ProjectileDamage < 0 || c.ShootCooldown < 0:
+		return fmt.Errorf("projectile settings must be positive")
+	case c.ManaRegen < 0 || c.RespawnTime < 0:
+		return fmt.Errorf("ManaRegen and RespawnTime cannot be negative")
+	case c.SaveInterval <= 0:
+		return fmt.Errorf("SaveInterval must be positive")
+	case c.SayRadius <= 0 || c.MaxChatLength < 1 || c.ChatBurst < 1 || c.ChatRefill <= 0 || c.MuteMinutes <= 0:
+		return fmt.Errorf("chat settings must be positive, and ChatBurst at least 1")
+	}
+	if c.Log != "" {
+		if err := logging.Validate(c.Log); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *config) tickTime() float64 {
+	return 1.0 / float64(c.TicksPerSecond)
+}
+
+func (c *config) saveInterval() time.Duration {
+	return time.Duration(c.SaveInterval * float64(time.Second))
+}
+
+func (c *config) muteDuration() time.Duration {
+	return time.Duration(c.MuteMinutes * float64(time.Minute))
+}
+
+// cfg returns the config in effect
+func (s *mmoServer) cfg() *config {
+	s.configLock.RLock()
+	defer s.configLock.RUnlock()
+	return s.config
+}
+
+// applyConfig puts a config into effect. It must be called from the game loop
+func (s *mmoServer) applyConfig(cfg *config) {
+	old := s.cfg()
+	if old != nil && !reflect.DeepEqual(old.Clients, cfg.Clients) {
+		serverLog.Warn("changes to Clients need a restart", "serving", old.Clients)
+		cfg.Clients = old.Clients
+	}
+	// levels changed from the admin console stay until the file changes them, as the tick rate does
+	if cfg.Log != "" && (old == nil || old.Log != cfg.Log) {
+		logging.Configure(cfg.Log)
+	}
+	s.configLock.Lock()
+	s.config = cfg
+	s.configLock.Unlock()
+	// a tick rate changed from the admin console stays until the file changes it
+	if old == nil || old.TicksPerSecond != cfg.TicksPerSecond {
+		s.tickTime = cfg.tickTime()
+	}
+	for _, id := range s.playerIDs() {
+		id := id
+		s.queueUpdate(func() error {
+			return s.sendGameSettings(id)
+		})
+	}
+}
+
+func (s *mmoServer) sendGameSettings(id string) error {
+	return s.send(id, &shared.Message{
+		Update: &shared.Update{GameSettings: &shared.GameSettings{
+			MoveSpeed:     s.cfg().MoveSpeed,
+			ShootCooldown: s.cfg().ShootCooldown,
+		}},
+	})
+}
+
+// reload rereads the config and ban files, keeping the current settings if the config is invalid
+func (s *mmoServer) reload() string {
+	result := "reloaded"
+	if cfg, err := s.readConfig(); err != nil {
+		result = "config not reloaded: " + err.Error()
+	} else {
+		s.applyConfig(cfg)
+	}
+	if err := s.reloadBans(); err != nil {
+		return result + ", bans not reloaded: " + err.Error()
+	}
+	kicked := 0
+	for _, b := range s.bans.List(s.now) {
+		kicked += s.kickMatching(b)
+	}
+	return fmt.Sprintf("%s, kicked %v banned player(s)", result, kicked)
+}
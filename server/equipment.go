@@ -0,0 +1,83 @@
+package main
+
+import (
+	"github.com/ilackarms/pkg/errors"
+	"github.com/mmogo/mmo/shared"
+)
+
+func (s *mmoServer) handleEquipRequest(id string, req *shared.EquipRequest) error {
+	s.playersLock.RLock()
+	player := s.players[id]
+	s.playersLock.RUnlock()
+	if player == nil {
+		return errors.New("requesting player "+id+" is nil??", nil)
+	}
+
+	def, ok := shared.Items[req.ItemID]
+	if !ok || def.Slot == "" {
+		s.queueNotice(id, "that cannot be equipped")
+		return nil
+	}
+	if !player.Inventory.Remove(def.ID, 1) {
+		s.queueNotice(id, "you do not have that")
+		return nil
+	}
+	// swap out whatever was worn before
+	if previous := player.Equipment.Get(def.Slot); previous != "" {
+		if left, err := player.Inventory.Add(previous, 1); err != nil || left > 0 {
+			player.Inventory.Add(def.ID, 1)
+			s.queueNotice(id, "your inventory is full")
+			return err
+		}
+	}
+	player.Equipment.Set(def.Slot, def.ID)
+	return s.equipmentChanged(player)
+}
+
+func (s *mmoServer) handleUnequipRequest(id string, req *shared.UnequipRequest) error {
+	s.playersLock.RLock()
+	player := s.players[id]
+	s.playersLock.RUnlock()
+	if player == nil {
+		return errors.New("requesting player "+id+" is nil??", nil)
+	}
+
+	itemID := player.Equipment.Get(req.Slot)
+	if itemID == "" {
+		s.queueNotice(id, "nothing is equipped there")
+		return nil
+	}
+	left, err := player.Inventory.Add(itemID, 1)
+	if err != nil {
+		return err
+	}
+	if left > 0 {
+		s.queueNotice(id, "your inventory is full")
+		return nil
+	}
+	player.Equipment.Set(req.Slot, "")
+	return s.equipmentChanged(player)
+}
+
+// equipmentChanged shows everyone the player's new gear and saves it
+func (s *mmoServer) equipmentChanged(player *shared.ServerPlayer) error {
+	id := player.ID
+	equipment := *player.Equipment
+	s.queueUpdate(func() error {
+		return s.broadcastEquipmentChanged(id, &equipment)
+	})
+	s.queueUpdate(func() error {
+		return s.sendInventory(id)
+	})
+	return s.savePlayer(player)
+}
+
+func (s *mmoServer) broadcastEquipmentChanged(id string, equipment *shared.Equipment) error {
+	equipmentChanged := &shared.Message{
+		Update: &shared.Update{EquipmentChanged: &shared.EquipmentChanged{
+			ID:        id,
+			Equipment: equipment,
+		}},
+	}
+	return s.broadcast(equipmentChanged)
+}
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"github.com/faiface/pixel"
+	"github.com/ilackarms/pkg/errors"
+	"github.com/mmogo/mmo/shared"
+)
+
+// spawnItem drops a stack of items on the ground
+func (s *mmoServer) spawnItem(itemID string, count int, pos pixel.Vec) {
+	s.spawnEntity(&shared.Entity{
+		Kind:     shared.E_ITEM,
+		Position: pos,
+		Item: &shared.Item{
+			ItemID: itemID,
+			Count:  count,
+		},
+	})
+}
+
+// dropLoot rolls the drops of a dead NPC
+func (s *mmoServer) dropLoot(n *npc) {
+	for _, drop := range n.template.Drops {
+		if s.rand.Float64() >= drop.Chance {
+			continue
+		}
+		s.spawnItem(drop.Item, drop.Count, s.randomPointNear(n.entity.Position, shared.TileSize/2))
+	}
+}
+
+func (s *mmoServer) handlePickupRequest(id string, req *shared.PickupRequest) error {
+	s.playersLock.RLock()
+	player := s.players[id]
+	s.playersLock.RUnlock()
+	if player == nil {
+		return errors.New("requesting player "+id+" is nil??", nil)
+	}
+
+	entity, ok := s.entities[req.EntityID]
+	if !ok || entity.Kind != shared.E_ITEM {
+		s.queueNotice(id, "there is nothing to pick up")
+		return nil
+	}
+	if entity.Position.Sub(player.Position).Len() > shared.PickupRadius {
+		s.queueNotice(id, "that is too far away")
+		return nil
+	}
+
+	left, err := player.Inventory.Add(entity.Item.ItemID, entity.Item.Count)
+	if err != nil {
+		return err
+	}
+	if left == entity.Item.Count {
+		s.queueNotice(id, "your inventory is full")
+		return nil
+	}
+	s.removeEntity(entity.ID, "")
+	if left > 0 {
+		s.spawnItem(entity.Item.ItemID, left, entity.Position)
+	}
+
+	s.queueUpdate(func() error {
+		return s.sendInventory(id)
+	})
+	return s.savePlayer(player)
+}
+
+func (s *mmoServer) sendInventory(id string) error {
+	s.playersLock.RLock()
+	player, ok := s.players[id]
+	s.playersLock.RUnlock()
+	if !ok {
+		return nil
+	}
+	return s.send(id, &shared.Message{
+		Update: &shared.Update{InventoryUpdated: &shared.InventoryUpdated{
+			Inventory: player.Inventory.Copy(),
+		}},
+	})
+}
+
+func (s *mmoServer) queueNotice(id, text string) {
+	s.queueUpdate(func() error {
+		return s.send(id, &shared.Message{
+			Update: &shared.Update{Notice: &shared.Notice{Text: text}},
+		})
+	})
+}
+
+// savePlayer queues the player to be saved to the store, without waiting for the disk
+func (s *mmoServer) savePlayer(player *shared.ServerPlayer) error {
+	record := &playerRecord{
+		ID:         player.ID,
+		Position:   player.Position,
+		Inventory:  player.Inventory,
+		Equipment:  player.Equipment,
+		Stats:      player.Stats,
+		Appearance: player.Appearance,
+	}
+	// the dead are saved as they will respawn, so they don't come back with no health
+	if !player.DeadUntil.IsZero() {
+		stats := *player.Stats
+		stats.Health, stats.Mana = stats.MaxHealth, stats.MaxMana
+		record.Stats, record.Position = &stats, playerSpawn
+	}
+	return s.store.Save(record)
+}
+
+// saveOnline queues everyone online to be saved, as otherwise they are only saved when
+// their belongings change or they leave. It must be called from the game loop
+func (s *mmoServer) saveOnline() int {
+	s.lastSave = s.now
+	s.playersLock.RLock()
+	defer s.playersLock.RUnlock()
+	saved := 0
+	for _, id := range sortedKeys(s.players) {
+		if err := s.savePlayer(s.players[id]); err != nil {
+			serverLog.Error("saving player failed", "player", id, "err", err)
+			continue
+		}
+		saved++
+	}
+	return saved
+}
@@ -3,36 +3,199 @@ package main
 import (
 	"flag"
 	"fmt"
+	"io"
 	"log"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
 
 	"github.com/mmogo/mmo/shared"
+	"github.com/mmogo/mmo/shared/logging"
 )
 
-func init() {
-	log.SetFlags(log.Lmicroseconds | log.Lshortfile)
-}
+var (
+	serverLog  = logging.New("server")
+	netLog     = logging.New("net")
+	tickLog    = logging.New("tick")
+	chatLog    = logging.New("chat")
+	accountLog = logging.New("account")
+)
 
 const (
-	ticksPerSecond = 10
-	tickTime       = 1.0 / ticksPerSecond
-
-	messagePerTickLimit = 60
+	pingInterval = 2 * time.Second // how often round trip times are measured
 )
 
 func main() {
 	port := flag.Int("port", 8080, "port to serve on")
 	protocol := flag.String("protocol", "udp", fmt.Sprintf("network protocol to use. available %s | %s", shared.ProtocolTCP, shared.ProtocolUDP))
+	spellFile := flag.String("spells", "", "json file of spell definitions. uses the built in spells if empty")
+	npcFile := flag.String("npcs", "", "json file of npc templates and spawns. uses the built in npcs if empty")
+	levelFile := flag.String("levels", "", "json file of the level progression. uses the built in progression if empty")
+	dataDir := flag.String("data", "data", "directory to save players in")
+	accountDir := flag.String("accounts", "accounts", "directory to save accounts in")
+	open := flag.Bool("open", false, "let anyone connect as any player without logging in, e.g. for the load bot")
+	accountsAddr := flag.String("accounts-addr", "", "address to serve the account endpoints on over TLS, e.g. :8443. needs -tls-cert and -tls-key. served over plain http on the game port if empty, which must then be behind a TLS proxy")
+	tlsCert := flag.String("tls-cert", "", "certificate file for -accounts-addr")
+	tlsKey := flag.String("tls-key", "", "private key file for -accounts-addr")
+	serverFile := flag.String("servers", "", "json file of the servers offered to players by the patcher. only this server if empty")
+	filterFile := flag.String("wordfilter", "", "file of words to mask in chat, one per line. chat is unfiltered if empty")
+	moderators := flag.String("moderators", "", "comma separated IDs of players allowed to /mute and /unmute. not allowed with -open")
+	admins := flag.String("admins", "", "comma separated IDs of players allowed to use admin commands in game. not allowed with -open")
+	auditFile := flag.String("audit", "admin.log", "file admin commands are recorded in")
+	adminSocket := flag.String("admin-socket", "", "unix socket to serve the admin console on. disabled if empty")
+	banFile := flag.String("bans", "bans.json", "file the ban list is kept in")
+	configFile := flag.String("config", "", "json config file, reread on SIGHUP. uses the built in settings if empty")
+	logLevels := flag.String("log", "", "log levels, a default then subsystem=level pairs, e.g. info,net=debug,tick=off. overrides the config at startup")
+	logFormat := flag.String("log-format", string(logging.LOGFMT), "log line format, logfmt or json")
+	recordFile := flag.String("record", "", "file to record the session in, for replaying with -replay. not recorded if empty")
+	replayFile := flag.String("replay", "", "recorded session to replay instead of serving. exits non-zero if the replay differs from the recording")
+	replayDump := flag.Bool("replay-dump", false, "print every message sent while replaying")
 	flag.Parse()
+	if *replayFile != "" {
+		if *logLevels != "" {
+			if err := logging.Configure(*logLevels); err != nil {
+				serverLog.Fatal("bad -log", "err", err)
+			}
+		}
+		var dump io.Writer
+		if *replayDump {
+			dump = os.Stdout
+		}
+		result, err := replaySession(*replayFile, dump)
+		if err != nil {
+			serverLog.Fatal("replay failed", "err", err)
+		}
+		fmt.Println(result)
+		return
+	}
+	// on open servers anyone can connect as anyone, moderators and admins included
+	if *open && *moderators != "" {
+		serverLog.Fatal("-moderators can't be used with -open")
+	}
+	if *open && *admins != "" {
+		serverLog.Fatal("-admins can't be used with -open, use the console or -admin-socket")
+	}
+	if *accountsAddr != "" && (*tlsCert == "" || *tlsKey == "") {
+		serverLog.Fatal("-accounts-addr needs -tls-cert and -tls-key")
+	}
+	cfg, err := loadConfig(*configFile)
+	if err != nil {
+		serverLog.Fatal("loading config", "err", err)
+	}
+	if *logLevels != "" {
+		// the flag wins over the config file
+		cfg.Log = *logLevels
+		if err := logging.Validate(cfg.Log); err != nil {
+			serverLog.Fatal("bad -log", "err", err)
+		}
+	}
+	if err := logging.SetFormat(logging.Format(*logFormat)); err != nil {
+		serverLog.Fatal("bad -log-format", "err", err)
+	}
+	store, err := newPlayerStore(*dataDir)
+	if err != nil {
+		serverLog.Fatal("startup failed", "err", err)
+	}
+	accounts, err := newAccountStore(*accountDir, store)
+	if err != nil {
+		serverLog.Fatal("startup failed", "err", err)
+	}
+	// players could otherwise make characters named as admins or moderators, so those are given out with claim
+	accounts.reserve(strings.Split(*moderators, ","))
+	accounts.reserve(strings.Split(*admins, ","))
+	var servers []shared.ServerInfo
+	if *serverFile != "" {
+		servers, err = loadServerList(*serverFile)
+		if err != nil {
+			serverLog.Fatal("startup failed", "err", err)
+		}
+	}
+	spells := shared.DefaultSpells
+	if *spellFile != "" {
+		spells, err = shared.LoadSpells(*spellFile)
+		if err != nil {
+			serverLog.Fatal("startup failed", "err", err)
+		}
+	}
+	npcDefs := defaultNPCDefinitions
+	if *npcFile != "" {
+		npcDefs, err = loadNPCDefinitions(*npcFile)
+		if err != nil {
+			serverLog.Fatal("startup failed", "err", err)
+		}
+	}
+	progression := shared.DefaultProgression
+	if *levelFile != "" {
+		progression, err = shared.LoadProgression(*levelFile)
+		if err != nil {
+			serverLog.Fatal("startup failed", "err", err)
+		}
+	}
+	var filter *wordFilter
+	if *filterFile != "" {
+		filter, err = loadWordFilter(*filterFile)
+		if err != nil {
+			serverLog.Fatal("startup failed", "err", err)
+		}
+	}
+	auditLog, err := os.OpenFile(*auditFile, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		serverLog.Fatal("startup failed", "err", err)
+	}
+	audit := log.New(auditLog, "", log.LstdFlags)
+	bans, err := loadBanList(*banFile)
+	if err != nil {
+		serverLog.Fatal("startup failed", "err", err)
+	}
 	errc := make(chan error)
-	server := newMMOServer()
-	go func() { log.Fatal(server.start(*protocol, *port, errc)) }()
+	server := newMMOServer(cfg, *configFile, store, spells, npcDefs, progression, filter,
+		strings.Split(*moderators, ","), strings.Split(*admins, ","), audit, bans, time.Now().UnixNano())
+	server.accounts = accounts
+	server.open = *open
+	server.servers = servers
+	server.accountsTLS = *accountsAddr
+	if *recordFile != "" {
+		if err := server.startRecording(*recordFile, npcDefs); err != nil {
+			serverLog.Fatal("startup failed", "err", err)
+		}
+	}
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	go func() {
+		for range hup {
+			serverLog.Info("reloading on SIGHUP", "result", server.adminCommand("signal", "reload"))
+		}
+	}()
+	// saves are written behind the game loop, so everyone online is saved and the saves
+	// written before exiting
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		sig := <-stop
+		serverLog.Info("shutting down", "signal", sig, "result", server.adminCommand("signal", "save"))
+		store.Close()
+		os.Exit(0)
+	}()
+	go server.serveConsole(os.Stdin, os.Stdout, "console")
+	if *adminSocket != "" {
+		go func() { serverLog.Fatal("admin socket failed", "err", server.serveAdminSocket(*adminSocket)) }()
+	}
+	if *accountsAddr != "" {
+		go func() {
+			serverLog.Fatal("account server failed", "err", accounts.serveTLS(*accountsAddr, *tlsCert, *tlsKey))
+		}()
+	}
+	go func() { serverLog.Fatal("server failed", "err", server.start(*protocol, *port, errc)) }()
 	for {
 		select {
 		case err := <-errc:
 			if shared.IsFatal(err) {
-				log.Fatal(err)
+				store.Close()
+				serverLog.Fatal("fatal error", "err", err)
 			}
-			log.Println("error:", err)
+			serverLog.Error("error", "err", err)
 		}
 	}
 }
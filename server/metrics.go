@@ -0,0 +1,195 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/mmogo/mmo/shared"
+)
+
+// tickBuckets are the upper bounds in seconds of the tick duration histogram
+var tickBuckets = []float64{0.001, 0.0025, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1}
+
+// metrics collects server telemetry and serves it in the Prometheus text format
+type metrics struct {
+	bytesIn      uint64
+	bytesOut     uint64
+	tickOverruns uint64
+	decodeErrors uint64
+	encodeErrors uint64
+
+	lock        sync.Mutex
+	tickCounts  []uint64 // observations per bucket, not cumulative
+	tickSum     float64
+	tickCount   uint64
+	messagesIn  map[string]uint64 // by message type
+	messagesOut map[string]uint64
+}
+
+func newMetrics() *metrics {
+	return &metrics{
+		tickCounts:  make([]uint64, len(tickBuckets)),
+		messagesIn:  make(map[string]uint64),
+		messagesOut: make(map[string]uint64),
+	}
+}
+
+// observeTick records how long a tick took and whether it ran over its time
+func (m *metrics) observeTick(took time.Duration, tickTime float64) {
+	seconds := took.Seconds()
+	if seconds > tickTime {
+		atomic.AddUint64(&m.tickOverruns, 1)
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.tickSum += seconds
+	m.tickCount++
+	for i, bound := range tickBuckets {
+		if seconds <= bound {
+			m.tickCounts[i]++
+			break
+		}
+	}
+}
+
+func (m *metrics) messageReceived(kind string) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.messagesIn[kind]++
+}
+
+func (m *metrics) messageSent(kind string, n int) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.messagesOut[kind] += uint64(n)
+}
+
+// countingConn counts the bytes passing through a connection
+type countingConn struct {
+	net.Conn
+	metrics *metrics
+}
+
+func (c *countingConn) Read(b []byte) (int, error) {
+	n, err := c.Conn.Read(b)
+	atomic.AddUint64(&c.metrics.bytesIn, uint64(n))
+	return n, err
+}
+
+func (c *countingConn) Write(b []byte) (int, error) {
+	n, err := c.Conn.Write(b)
+	atomic.AddUint64(&c.metrics.bytesOut, uint64(n))
+	return n, err
+}
+
+// serveMetrics writes the server's metrics in the Prometheus text exposition format
+func (s *mmoServer) serveMetrics(w http.ResponseWriter, req *http.Request) {
+	m := s.metrics
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+
+	s.playersLock.RLock()
+	players := len(s.players)
+	queued, longest := 0, 0
+	for _, player := range s.players {
+		player.QueueLock.RLock()
+		n := len(player.RequestQueue)
+		player.QueueLock.RUnlock()
+		queued += n
+		if n > longest {
+			longest = n
+		}
+	}
+	s.playersLock.RUnlock()
+	s.updatesLock.Lock()
+	updates := len(s.updates)
+	s.updatesLock.Unlock()
+
+	writeMetric(w, "mmo_players_connected", "gauge", "Players currently connected.", float64(players))
+	writeMetric(w, "mmo_update_queue_depth", "gauge", "Updates waiting to be sent at the end of the tick.", float64(updates))
+	// totals rather than a series per player, which would grow without bound
+	writeMetric(w, "mmo_request_queue_depth", "gauge", "Requests waiting to be handled, from all players.", float64(queued))
+	writeMetric(w, "mmo_request_queue_depth_max", "gauge", "Requests waiting to be handled from the player with the most.", float64(longest))
+
+	writeMetric(w, "mmo_bytes_received_total", "counter", "Bytes read from players.", float64(atomic.LoadUint64(&m.bytesIn)))
+	writeMetric(w, "mmo_bytes_sent_total", "counter", "Bytes written to players.", float64(atomic.LoadUint64(&m.bytesOut)))
+	writeMetric(w, "mmo_tick_overruns_total", "counter", "Ticks which took longer than the tick time.", float64(atomic.LoadUint64(&m.tickOverruns)))
+	writeMetric(w, "mmo_decode_errors_total", "counter", "Messages from players which could not be decoded.", float64(atomic.LoadUint64(&m.decodeErrors)))
+	writeMetric(w, "mmo_encode_errors_total", "counter", "Messages to players which could not be encoded.", float64(atomic.LoadUint64(&m.encodeErrors)))
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	writeHeader(w, "mmo_messages_received_total", "counter", "Messages received, by type.")
+	for _, kind := range sortedKeys(m.messagesIn) {
+		fmt.Fprintf(w, "mmo_messages_received_total{type=\"%s\"} %v\n", labelValue(kind), m.messagesIn[kind])
+	}
+	writeHeader(w, "mmo_messages_sent_total", "counter", "Messages sent, by type.")
+	for _, kind := range sortedKeys(m.messagesOut) {
+		fmt.Fprintf(w, "mmo_messages_sent_total{type=\"%s\"} %v\n", labelValue(kind), m.messagesOut[kind])
+	}
+
+	writeHeader(w, "mmo_tick_duration_seconds", "histogram", "Time taken to run a tick.")
+	cumulative := uint64(0)
+	for i, bound := range tickBuckets {
+		cumulative += m.tickCounts[i]
+		fmt.Fprintf(w, "mmo_tick_duration_seconds_bucket{le=\"%v\"} %v\n", bound, cumulative)
+	}
+	fmt.Fprintf(w, "mmo_tick_duration_seconds_bucket{le=\"+Inf\"} %v\n", m.tickCount)
+	fmt.Fprintf(w, "mmo_tick_duration_seconds_sum %v\n", m.tickSum)
+	fmt.Fprintf(w, "mmo_tick_duration_seconds_count %v\n", m.tickCount)
+}
+
+func writeHeader(w io.Writer, name, kind, help string) {
+	fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, kind)
+}
+
+func writeMetric(w io.Writer, name, kind, help string, value float64) {
+	writeHeader(w, name, kind, help)
+	fmt.Fprintf(w, "%s %v\n", name, value)
+}
+
+// sortedKeys returns the keys of a map in order. The game loop iterates over players,
+// entities and NPCs this way so that it does the same thing every time it is replayed
+func sortedKeys(m interface{}) []string {
+	keys := []string{}
+	switch m := m.(type) {
+	case map[string]int:
+		for k := range m {
+			keys = append(keys, k)
+		}
+	case map[string]uint64:
+		for k := range m {
+			keys = append(keys, k)
+		}
+	case map[string]*shared.ServerPlayer:
+		for k := range m {
+			keys = append(keys, k)
+		}
+	case map[string]*shared.Entity:
+		for k := range m {
+			keys = append(keys, k)
+		}
+	case map[string]*npc:
+		for k := range m {
+			keys = append(keys, k)
+		}
+	case map[string]bool:
+		for k := range m {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// labelValue escapes a label value for the exposition format, which only escapes
+// backslashes, double quotes and newlines
+func labelValue(v string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(v)
+}
@@ -0,0 +1,185 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// wordFilter masks unwanted words in chat
+type wordFilter struct {
+	pattern *regexp.Regexp // nil when there are no words
+}
+
+// loadWordFilter reads a word filter file with one word or phrase per line.
+// Blank lines and lines starting with # are ignored
+func loadWordFilter(path string) (*wordFilter, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	words := []string{}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		words = append(words, regexp.QuoteMeta(line))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading %s: %v", path, err)
+	}
+	if len(words) == 0 {
+		return &wordFilter{}, nil
+	}
+	// \b only knows ASCII letters, so words are bounded by anything but a letter, mark,
+	// digit or _ in any script
+	return &wordFilter{pattern: regexp.MustCompile(`(?i)(?:^|[^\pL\pM\pN_])(` + strings.Join(words, "|") + `)(?:[^\pL\pM\pN_]|$)`)}, nil
+}
+
+// Filter replaces every filtered word in text with an asterisk per character
+func (f *wordFilter) Filter(text string) string {
+	if f == nil || f.pattern == nil {
+		return text
+	}
+	// the characters either side of a word are matched too, so each search starts from
+	// the end of the last word found, so that a word right after it is found as well
+	var filtered bytes.Buffer
+	from := 0
+	for from < len(text) {
+		loc := f.pattern.FindStringSubmatchIndex(text[from:])
+		if loc == nil {
+			break
+		}
+		start, end := from+loc[2], from+loc[3]
+		filtered.WriteString(text[from:start])
+		filtered.WriteString(strings.Repeat("*", utf8.RuneCountInString(text[start:end])))
+		from = end
+	}
+	filtered.WriteString(text[from:])
+	return filtered.String()
+}
+
+// chatAllowance limits how quickly a player can chat. Each message spends a token,
+// and tokens come back at the configured ChatRefill per second up to ChatBurst
+type chatAllowance struct {
+	tokens float64
+	last   time.Time
+}
+
+// allowChat spends one of the player's chat tokens, returning false if they have none left
+func (s *mmoServer) allowChat(id string, now time.Time) bool {
+	cfg := s.cfg()
+	s.chatLock.Lock()
+	defer s.chatLock.Unlock()
+	allowance, ok := s.chatAllowances[id]
+	if !ok {
+		allowance = &chatAllowance{tokens: cfg.ChatBurst, last: now}
+		s.chatAllowances[id] = allowance
+	}
+	allowance.tokens += now.Sub(allowance.last).Seconds() * cfg.ChatRefill
+	if allowance.tokens > cfg.ChatBurst {
+		allowance.tokens = cfg.ChatBurst
+	}
+	allowance.last = now
+	if allowance.tokens < 1 {
+		return false
+	}
+	allowance.tokens--
+	return true
+}
+
+// forgetChat drops the chat allowance of a player who left
+func (s *mmoServer) forgetChat(id string) {
+	s.chatLock.Lock()
+	defer s.chatLock.Unlock()
+	delete(s.chatAllowances, id)
+}
+
+// mutedUntil returns when a player's mute ends, or the zero time if they are not muted
+func (s *mmoServer) mutedUntil(id string, now time.Time) time.Time {
+	s.chatLock.Lock()
+	defer s.chatLock.Unlock()
+	until, ok := s.mutes[strings.ToLower(id)]
+	if ok && !now.Before(until) {
+		delete(s.mutes, strings.ToLower(id))
+		return time.Time{}
+	}
+	return until
+}
+
+func (s *mmoServer) mute(id string, until time.Time) {
+	s.chatLock.Lock()
+	defer s.chatLock.Unlock()
+	s.mutes[strings.ToLower(id)] = until
+}
+
+// unmute lifts a mute, returning false if the player was not muted
+func (s *mmoServer) unmute(id string) bool {
+	s.chatLock.Lock()
+	defer s.chatLock.Unlock()
+	_, ok := s.mutes[strings.ToLower(id)]
+	delete(s.mutes, strings.ToLower(id))
+	return ok
+}
+
+// moderate checks a player may speak and filters what they said.
+// It tells the player why when they may not
+func (s *mmoServer) moderate(id, text string) (string, bool) {
+	now := s.now
+	if until := s.mutedUntil(id, now); !until.IsZero() {
+		s.queueSystemChat(id, fmt.Sprintf("you are muted for another %v", until.Sub(now)/time.Second*time.Second))
+		return "", false
+	}
+	return s.wordFilter.Filter(text), true
+}
+
+// handleMuteCommand handles /mute name [duration] and /unmute name from moderators
+func (s *mmoServer) handleMuteCommand(id, command, args string) {
+	if !s.moderators[strings.ToLower(id)] && !s.admins[strings.ToLower(id)] {
+		s.queueSystemChat(id, "only moderators can /"+command)
+		return
+	}
+	fields := strings.Fields(args)
+	if len(fields) == 0 {
+		s.queueSystemChat(id, "usage: /mute name [duration], /unmute name")
+		return
+	}
+	// told to the player as they are known if they are online
+	target := fields[0]
+	if online, ok := s.onlineID(target); ok {
+		target = online
+	}
+
+	if command == "unmute" {
+		if !s.unmute(target) {
+			s.queueSystemChat(id, target+" is not muted")
+			return
+		}
+		chatLog.Info("unmuted", "player", target, "by", id)
+		s.queueSystemChat(id, "unmuted "+target)
+		s.queueSystemChat(target, "you are no longer muted")
+		return
+	}
+
+	duration := s.cfg().muteDuration()
+	if len(fields) > 1 {
+		d, err := time.ParseDuration(fields[1])
+		if err != nil || d <= 0 {
+			s.queueSystemChat(id, fmt.Sprintf("bad duration %q, use e.g. 30s, 10m or 2h", fields[1]))
+			return
+		}
+		duration = d
+	}
+	s.mute(target, s.now.Add(duration))
+	chatLog.Info("muted", "player", target, "by", id, "for", duration)
+	s.queueSystemChat(id, fmt.Sprintf("muted %s for %v", target, duration))
+	s.queueSystemChat(target, fmt.Sprintf("you have been muted for %v", duration))
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestWordFilter(t *testing.T) {
+	dir, err := ioutil.TempDir("", "mmo-filter")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "filter.txt")
+	words := "# filtered words\ndarn\nscheiß\nмат\nbad word\n"
+	if err := ioutil.WriteFile(path, []byte(words), 0644); err != nil {
+		t.Fatal(err)
+	}
+	filter, err := loadWordFilter(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		text string
+		want string
+	}{
+		{text: "darn it", want: "**** it"},
+		{text: "DARN!", want: "****!"},
+		{text: "darn darn,darn", want: "**** ****,****"},
+		{text: "darning socks", want: "darning socks"},
+		{text: "so scheiß", want: "so ******"},
+		{text: "SCHEISS", want: "SCHEISS"},
+		{text: "Scheiß!", want: "******!"},
+		{text: "scheißegal", want: "scheißegal"},
+		{text: "это мат", want: "это ***"},
+		{text: "математика", want: "математика"},
+		{text: "what a bad word.", want: "what a ********."},
+		{text: "under_darn", want: "under_darn"},
+	}
+	for _, test := range tests {
+		if got := filter.Filter(test.text); got != test.want {
+			t.Errorf("filtered %q to %q, want %q", test.text, got, test.want)
+		}
+	}
+}
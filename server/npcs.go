@@ -0,0 +1,400 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"time"
+
+	"github.com/faiface/pixel"
+	"github.com/mmogo/mmo/shared"
+	"github.com/mmogo/mmo/shared/pathfind"
+)
+
+// npcTemplate describes a kind of NPC
+type npcTemplate struct {
+	Name           string
+	Color          string // tint, from golang.org/x/image/colornames
+	Health         float64
+	Speed          float64 // units per second
+	AggroRadius    float64 // distance at which players are noticed
+	AttackRadius   float64
+	AttackDamage   float64
+	AttackCooldown float64 // seconds
+	FleeHealth     float64 // health below which the NPC runs away, once, for npcFleeTime
+	RespawnTime    float64 // seconds
+	XP             int     // experience awarded for the kill
+	Drops          []*npcDrop
+}
+
+// npcDrop is an item an NPC may leave behind when it dies
+type npcDrop struct {
+	Item   string
+	Count  int
+	Chance float64 // 0 to 1
+}
+
+// npcSpawn places NPCs of a template around a point
+type npcSpawn struct {
+	Template     string
+	Position     pixel.Vec
+	Count        int
+	WanderRadius float64
+}
+
+// npcDefinitions is the format of the NPC definition file
+type npcDefinitions struct {
+	Templates []*npcTemplate
+	Spawns    []*npcSpawn
+}
+
+var defaultNPCDefinitions = &npcDefinitions{
+	Templates: []*npcTemplate{
+		{Name: "goblin", Color: "green", Health: 40, Speed: 80, AggroRadius: 200, AttackRadius: 40,
+			AttackDamage: 5, AttackCooldown: 1.5, FleeHealth: 10, RespawnTime: 15, XP: 30,
+			Drops: []*npcDrop{{Item: "gold", Count: 5, Chance: 1}, {Item: "goblin_ear", Count: 1, Chance: 0.5},
+				{Item: "leather_cap", Count: 1, Chance: 0.2}, {Item: "leather_pants", Count: 1, Chance: 0.2}}},
+		{Name: "wolf", Color: "gray", Health: 30, Speed: 120, AggroRadius: 260, AttackRadius: 36,
+			AttackDamage: 4, AttackCooldown: 1, FleeHealth: 0, RespawnTime: 20, XP: 20,
+			Drops: []*npcDrop{{Item: "wolf_pelt", Count: 1, Chance: 0.6}, {Item: "leather_armor", Count: 1, Chance: 0.15}}},
+	},
+	Spawns: []*npcSpawn{
+		{Template: "goblin", Position: pixel.V(400, 200), Count: 3, WanderRadius: 150},
+		{Template: "wolf", Position: pixel.V(-500, -100), Count: 2, WanderRadius: 250},
+	},
+}
+
+// loadNPCDefinitions reads and validates NPC templates and spawns from a JSON file
+func loadNPCDefinitions(path string) (*npcDefinitions, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var defs npcDefinitions
+	if err := json.Unmarshal(data, &defs); err != nil {
+		return nil, fmt.Errorf("parsing %s: %v", path, err)
+	}
+	templates := make(map[string]bool)
+	for _, template := range defs.Templates {
+		switch {
+		case template.Name == "" || template.Health <= 0:
+			return nil, fmt.Errorf("npc template %q needs a name and positive health", template.Name)
+		case templates[template.Name]:
+			return nil, fmt.Errorf("duplicate npc template %q", template.Name)
+		case template.Speed <= 0 || template.AttackCooldown <= 0:
+			return nil, fmt.Errorf("npc template %q needs a positive Speed and AttackCooldown", template.Name)
+		case template.AggroRadius < 0 || template.AttackRadius < 0 || template.AttackDamage < 0 ||
+			template.FleeHealth < 0 || template.RespawnTime < 0 || template.XP < 0:
+			return nil, fmt.Errorf("npc template %q has negative values", template.Name)
+		}
+		templates[template.Name] = true
+		for _, drop := range template.Drops {
+			if _, ok := shared.Items[drop.Item]; !ok || drop.Count <= 0 {
+				return nil, fmt.Errorf("npc template %q drops unknown item %q", template.Name, drop.Item)
+			}
+		}
+	}
+	for _, spawn := range defs.Spawns {
+		if !templates[spawn.Template] {
+			return nil, fmt.Errorf("spawn uses unknown npc template %q", spawn.Template)
+		}
+	}
+	return &defs, nil
+}
+
+type npcState int
+
+const (
+	npcIdle npcState = iota
+	npcWander
+	npcChase
+	npcAttack
+	npcFlee
+)
+
+func (s npcState) String() string {
+	switch s {
+	case npcIdle:
+		return "idle"
+	case npcWander:
+		return "wander"
+	case npcChase:
+		return "chase"
+	case npcAttack:
+		return "attack"
+	case npcFlee:
+		return "flee"
+	default:
+		return fmt.Sprintf("invalid npc state: %v", int(s))
+	}
+}
+
+// npc is the server side AI of an NPC entity
+type npc struct {
+	entity     *shared.Entity
+	template   *npcTemplate
+	spawn      *npcSpawn
+	health     float64
+	state      npcState
+	target     string      // player being chased or attacked
+	wanderTo   pixel.Vec   // destination while wandering
+	path       []pixel.Vec // waypoints still to walk to reach pathGoal
+	pathGoal   shared.Tile
+	decideAt   time.Time // when an idle NPC next considers wandering
+	fleeUntil  time.Time // when it turns back after running away, which it only does once a life
+	lastAttack time.Time
+	deadUntil  time.Time // zero while alive
+}
+
+// spawnNPCs creates every NPC listed in the definitions
+func (s *mmoServer) spawnNPCs(defs *npcDefinitions) {
+	templates := make(map[string]*npcTemplate)
+	for _, template := range defs.Templates {
+		templates[template.Name] = template
+	}
+	for _, spawn := range defs.Spawns {
+		for i := 0; i < spawn.Count; i++ {
+			n := &npc{
+				template: templates[spawn.Template],
+				spawn:    spawn,
+			}
+			s.respawnNPC(n)
+		}
+	}
+}
+
+// respawnNPC places a fresh entity for the NPC somewhere around its spawn point
+func (s *mmoServer) respawnNPC(n *npc) {
+	n.health = n.template.Health
+	n.state = npcIdle
+	n.target = ""
+	n.deadUntil = time.Time{}
+	n.fleeUntil = time.Time{}
+	n.path = nil
+	n.entity = &shared.Entity{
+		Kind:     shared.E_NPC,
+		Position: s.randomPointNear(n.spawn.Position, n.spawn.WanderRadius),
+		Facing:   shared.DOWN,
+		Action:   shared.A_IDLE,
+		NPC: &shared.NPC{
+			Name:  n.template.Name,
+			Color: n.template.Color,
+		},
+	}
+	s.spawnEntity(n.entity)
+	s.npcs[n.entity.ID] = n
+}
+
+func (s *mmoServer) randomPointNear(center pixel.Vec, radius float64) pixel.Vec {
+	for i := 0; i < 10; i++ {
+		offset := pixel.V(radius*math.Sqrt(s.rand.Float64()), 0).Rotated(s.rand.Float64() * 2 * math.Pi)
+		if p := center.Add(offset); !s.tileMap.BlockedAt(p) {
+			return p
+		}
+	}
+	return center
+}
+
+// respawnNPCs brings back dead NPCs whose respawn time has passed
+func (s *mmoServer) respawnNPCs() {
+	for _, id := range sortedKeys(s.npcs) {
+		n := s.npcs[id]
+		if !n.deadUntil.IsZero() && s.now.After(n.deadUntil) {
+			delete(s.npcs, id)
+			s.respawnNPC(n)
+		}
+	}
+}
+
+// npcFleeTime is how long a hurt NPC runs away before turning back to fight on.
+// Nothing heals NPCs, so otherwise they would run for the rest of their lives
+const npcFleeTime = 5 * time.Second
+
+// updateNPC runs the behaviour of a living NPC for one tick. Players are the IDs of
+// everyone online, in order
+func (s *mmoServer) updateNPC(n *npc, now time.Time, players []string) {
+	entity := n.entity
+	before := *entity
+	nearestID, nearest := s.nearestPlayer(players, entity.Position, n.template.AggroRadius)
+	if n.state == npcFlee && !now.Before(n.fleeUntil) {
+		n.state = npcIdle
+	}
+
+	// pick the state for this tick
+	switch {
+	case n.state == npcFlee:
+		// still running away
+	case n.health <= n.template.FleeHealth && n.fleeUntil.IsZero():
+		n.state = npcFlee
+		n.fleeUntil = now.Add(npcFleeTime)
+		n.target = ""
+	case n.state == npcChase || n.state == npcAttack:
+		target := s.livingPlayer(n.target)
+		switch {
+		case target == nil || target.Position.Sub(entity.Position).Len() > n.template.AggroRadius*2:
+			n.state = npcIdle
+			n.target = ""
+		case target.Position.Sub(entity.Position).Len() <= n.template.AttackRadius:
+			n.state = npcAttack
+		default:
+			n.state = npcChase
+		}
+	case nearest != nil:
+		n.state = npcChase
+		n.target = nearestID
+	case n.state == npcIdle && now.After(n.decideAt):
+		n.state = npcWander
+		n.wanderTo = s.randomPointNear(n.spawn.Position, n.spawn.WanderRadius)
+	}
+
+	// act on it
+	entity.Action = shared.A_IDLE
+	switch n.state {
+	case npcIdle:
+	case npcWander:
+		if s.moveNPCAlongPath(n, n.wanderTo) {
+			n.state = npcIdle
+			n.decideAt = now.Add(seconds(2 + s.rand.Float64()*4))
+		}
+	case npcChase:
+		if target := s.livingPlayer(n.target); target != nil {
+			s.moveNPCAlongPath(n, target.Position)
+		}
+	case npcAttack:
+		target := s.livingPlayer(n.target)
+		if target == nil {
+			break
+		}
+		entity.Facing = faceToward(entity.Position, target.Position, entity.Facing)
+		if now.Sub(n.lastAttack) >= seconds(n.template.AttackCooldown) {
+			n.lastAttack = now
+			entity.Action = shared.A_SLASH
+			s.damagePlayer(n.target, n.template.AttackDamage)
+		}
+	case npcFlee:
+		if nearest == nil {
+			n.state = npcIdle
+			break
+		}
+		away := entity.Position.Add(entity.Position.Sub(nearest.Position))
+		s.moveNPCToward(n, away)
+	}
+
+	if entity.Position != before.Position || entity.Facing != before.Facing || entity.Action != before.Action {
+		moved := entity.Copy()
+		s.queueUpdate(func() error {
+			return s.broadcastEntityMoved(moved, now)
+		})
+	}
+}
+
+// npcPathNodes bounds NPC path searches, which only need to cover their aggro range
+const npcPathNodes = 512
+
+// moveNPCAlongPath steps the NPC along a path around blocked tiles to a point and reports
+// whether it arrived. The path is planned again when the point moves to another tile.
+// An unreachable point counts as arrived, so wandering NPCs pick somewhere else
+func (s *mmoServer) moveNPCAlongPath(n *npc, to pixel.Vec) bool {
+	goal := shared.TileAt(to)
+	if n.path == nil || goal != n.pathGoal {
+		path, ok := pathfind.Path(s.tileMap, n.entity.Position, to, npcPathNodes)
+		if !ok {
+			n.path = nil
+			return true
+		}
+		n.path, n.pathGoal = path, goal
+	}
+	// the last waypoint follows the point as it moves within its tile
+	n.path[len(n.path)-1] = to
+	if s.moveNPCToward(n, n.path[0]) {
+		n.path = n.path[1:]
+	}
+	if len(n.path) == 0 {
+		n.path = nil
+		return true
+	}
+	return false
+}
+
+// moveNPCToward steps the NPC toward a point and reports whether it arrived
+func (s *mmoServer) moveNPCToward(n *npc, to pixel.Vec) bool {
+	entity := n.entity
+	delta := to.Sub(entity.Position)
+	step := n.template.Speed * s.tickTime
+	if delta.Len() <= step {
+		if s.tileMap.BlockedAt(to) {
+			return true
+		}
+		entity.Position = to
+		return true
+	}
+	next := entity.Position.Add(delta.Unit().Scaled(step))
+	if s.tileMap.BlockedAt(next) {
+		return true
+	}
+	entity.Position = next
+	entity.Facing = faceToward(entity.Position, to, entity.Facing)
+	entity.Action = shared.A_WALK
+	return false
+}
+
+// damageNPC hurts an NPC and kills it when its health runs out
+func (s *mmoServer) damageNPC(n *npc, amount float64, attackerID string) {
+	if !n.deadUntil.IsZero() {
+		return
+	}
+	n.health -= amount
+	if n.health > 0 {
+		if n.state != npcFlee && s.livingPlayer(attackerID) != nil {
+			n.state = npcChase
+			n.target = attackerID
+		}
+		return
+	}
+	n.deadUntil = s.now.Add(seconds(n.template.RespawnTime))
+	s.removeEntity(n.entity.ID, "")
+	s.dropLoot(n)
+	s.awardXP(attackerID, n.template.XP, "killed "+n.template.Name)
+}
+
+// nearestPlayer returns the closest living player of those given within radius of pos
+func (s *mmoServer) nearestPlayer(ids []string, pos pixel.Vec, radius float64) (string, *shared.ServerPlayer) {
+	s.playersLock.RLock()
+	defer s.playersLock.RUnlock()
+	var nearestID string
+	var nearest *shared.ServerPlayer
+	for _, id := range ids {
+		player := s.players[id]
+		if player == nil || player.Health <= 0 {
+			continue
+		}
+		if d := player.Position.Sub(pos).Len(); d <= radius {
+			radius = d
+			nearestID, nearest = id, player
+		}
+	}
+	return nearestID, nearest
+}
+
+// livingPlayer returns the player if they are still connected and alive
+func (s *mmoServer) livingPlayer(id string) *shared.ServerPlayer {
+	s.playersLock.RLock()
+	defer s.playersLock.RUnlock()
+	player := s.players[id]
+	if player == nil || player.Health <= 0 {
+		return nil
+	}
+	return player
+}
+
+// faceToward returns the direction from one point to another, or current if they are the same
+func faceToward(from, to pixel.Vec, current shared.Direction) shared.Direction {
+	if from == to {
+		return current
+	}
+	if dir := shared.ScreenDirection(to.Sub(from)); dir != shared.DIR_NONE {
+		return dir
+	}
+	return current
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/faiface/pixel"
+)
+
+func TestNPCStopsFleeing(t *testing.T) {
+	s, join, done := newTestServer(t)
+	defer done()
+	alice := join("alice")
+	var goblin *npc
+	for _, id := range sortedKeys(s.npcs) {
+		if n := s.npcs[id]; n.template.Name == "goblin" {
+			goblin = n
+			break
+		}
+	}
+	if goblin == nil {
+		t.Fatal("no goblin")
+	}
+	goblin.health = goblin.template.FleeHealth
+	alice.Position = goblin.entity.Position.Add(pixel.V(goblin.template.AggroRadius/2, 0))
+
+	tests := []struct {
+		after time.Duration
+		want  npcState
+	}{
+		{after: 0, want: npcFlee},
+		{after: npcFleeTime / 2, want: npcFlee},
+		{after: npcFleeTime, want: npcChase},
+		// hurt as it is, it won't run away again
+		{after: npcFleeTime + time.Second, want: npcChase},
+	}
+	start := s.now
+	for _, test := range tests {
+		s.now = start.Add(test.after)
+		s.updateNPC(goblin, s.now, s.playerIDs())
+		if goblin.state != test.want {
+			t.Errorf("after %v the goblin is %v, want %v", test.after, goblin.state, test.want)
+		}
+	}
+
+	// it runs away again once it has respawned
+	s.respawnNPC(goblin)
+	goblin.health = goblin.template.FleeHealth
+	s.updateNPC(goblin, s.now, s.playerIDs())
+	if goblin.state != npcFlee {
+		t.Errorf("respawned goblin is %v, want %v", goblin.state, npcFlee)
+	}
+}
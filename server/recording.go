@@ -0,0 +1,438 @@
+package main
+
+import (
+	"compress/gzip"
+	"encoding/binary"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"hash/fnv"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/mmogo/mmo/shared"
+)
+
+// recordingVersion changes whenever the recording format does
+const recordingVersion = 1
+
+// A recording is a gzipped stream of gob values: a recordingHeader, then for each tick
+// an entry starting it, an entry for each input the game loop took during it, and an entry
+// ending it with a digest of the messages the tick sent. Replaying the inputs on a server
+// rebuilt from the header must send the same messages
+
+// recordingHeader holds what is needed to rebuild the world as it was when recording started
+type recordingHeader struct {
+	Version     int
+	Started     time.Time
+	Seed        int64
+	Config      *config
+	Spells      []*shared.Spell
+	NPCs        *npcDefinitions
+	Progression *shared.Progression
+	WordFilter  string // pattern, empty if chat is unfiltered
+	Moderators  []string
+	Admins      []string
+	Bans        []*ban
+	World       uint64 // digest of the entities in the world, to check a replay rebuilt it the same
+}
+
+// entry is one value of a recording after the header. Exactly one field is set
+type entry struct {
+	Start *tickStart
+	Event *event
+	End   *tickEnd
+}
+
+type tickStart struct {
+	Tick uint64
+	Time time.Time
+}
+
+type tickEnd struct {
+	Sent   int    // messages sent, counting one per recipient
+	Digest uint64 // of the messages sent
+}
+
+// event is an input to the game loop. Exactly one field is set
+type event struct {
+	Join    *joinEvent
+	Leave   string
+	Admin   *adminEvent
+	Request *requestEvent
+	Reload  *reloadEvent
+}
+
+type joinEvent struct {
+	ID     string
+	Addr   string
+	Record *playerRecord
+}
+
+// adminEvent is an admin command from the console, admin socket or SIGHUP.
+// Commands typed into chat are replayed with the request they came in
+type adminEvent struct {
+	Source string
+	Line   string
+}
+
+type requestEvent struct {
+	ID      string
+	Request *shared.Request
+}
+
+// reloadEvent is the result of rereading the config or ban file
+type reloadEvent struct {
+	Config *config
+	Bans   []*ban
+	Err    string
+}
+
+func (e *reloadEvent) err() error {
+	if e.Err == "" {
+		return nil
+	}
+	return fmt.Errorf("%s", e.Err)
+}
+
+func errString(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
+
+// digest hashes the messages sent to each player during a tick. Messages are hashed
+// as JSON rather than as they were sent, since bson writes maps in a random order
+type digest struct {
+	players map[string]hash.Hash64
+	sent    int
+}
+
+func newDigest() *digest {
+	return &digest{players: make(map[string]hash.Hash64)}
+}
+
+// add hashes a message sent to each of a list of players, returning the JSON hashed
+func (d *digest) add(to []string, msg *shared.Message) []byte {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		data = []byte(err.Error())
+	}
+	for _, id := range to {
+		h, ok := d.players[id]
+		if !ok {
+			h = fnv.New64a()
+			d.players[id] = h
+		}
+		h.Write(data)
+	}
+	d.sent += len(to)
+	return data
+}
+
+func (d *digest) sum() uint64 {
+	ids := make([]string, 0, len(d.players))
+	for id := range d.players {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	h := fnv.New64a()
+	for _, id := range ids {
+		h.Write([]byte(id))
+		binary.Write(h, binary.LittleEndian, d.players[id].Sum64())
+	}
+	return h.Sum64()
+}
+
+// worldDigest hashes the players and entities in the world
+func (s *mmoServer) worldDigest() uint64 {
+	d := newDigest()
+	s.playersLock.RLock()
+	for _, id := range sortedKeys(s.players) {
+		d.add([]string{"world"}, &shared.Message{Update: &shared.Update{
+			EntitySpawned: &shared.EntitySpawned{Entity: s.players[id].Entity}}})
+	}
+	s.playersLock.RUnlock()
+	for _, id := range sortedKeys(s.entities) {
+		d.add([]string{"world"}, &shared.Message{Update: &shared.Update{
+			EntitySpawned: &shared.EntitySpawned{Entity: s.entities[id]}}})
+	}
+	return d.sum()
+}
+
+// recorder writes a recording of the game loop to a file
+type recorder struct {
+	file      *os.File
+	zip       *gzip.Writer
+	enc       *gob.Encoder
+	err       error // the first write which failed
+	lastFlush time.Time
+}
+
+// startRecording records the session to a file from the next tick on.
+// It must be called before the game loop starts
+func (s *mmoServer) startRecording(path string, npcDefs *npcDefinitions) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	r := &recorder{file: f, zip: gzip.NewWriter(f), lastFlush: time.Now()}
+	r.enc = gob.NewEncoder(r.zip)
+	header := &recordingHeader{
+		Version:     recordingVersion,
+		Started:     time.Now(),
+		Seed:        s.seed,
+		Config:      s.cfg(),
+		Spells:      s.spellList,
+		NPCs:        npcDefs,
+		Progression: s.progression,
+		Moderators:  sortedKeys(s.moderators),
+		Admins:      sortedKeys(s.admins),
+		Bans:        s.bans.List(time.Now()),
+		World:       s.worldDigest(),
+	}
+	if s.wordFilter != nil && s.wordFilter.pattern != nil {
+		header.WordFilter = s.wordFilter.pattern.String()
+	}
+	if err := r.enc.Encode(header); err != nil {
+		f.Close()
+		return err
+	}
+	s.recorder = r
+	return nil
+}
+
+func (r *recorder) write(e *entry) {
+	if r.err == nil {
+		r.err = r.enc.Encode(e)
+	}
+}
+
+func (r *recorder) startTick(tick uint64, now time.Time) {
+	r.write(&entry{Start: &tickStart{Tick: tick, Time: now}})
+}
+
+// finishTick ends the tick, flushing the recording to the file about once a second
+// so that little is lost if the server dies
+func (r *recorder) finishTick(d *digest) error {
+	r.write(&entry{End: &tickEnd{Sent: d.sent, Digest: d.sum()}})
+	if r.err == nil && time.Since(r.lastFlush) >= time.Second {
+		r.err = r.zip.Flush()
+		r.lastFlush = time.Now()
+	}
+	if r.err != nil {
+		r.zip.Close()
+		r.file.Close()
+	}
+	return r.err
+}
+
+// recordEvent records an input to the game loop if the session is being recorded
+func (s *mmoServer) recordEvent(e *event) {
+	if s.recorder != nil {
+		s.recorder.write(&entry{Event: e})
+	}
+}
+
+// recordSent adds a message sent to some players to the digest of the tick
+func (s *mmoServer) recordSent(to []string, msg *shared.Message) {
+	if s.digest == nil {
+		return
+	}
+	data := s.digest.add(to, msg)
+	if s.replay != nil && s.replay.dump != nil {
+		fmt.Fprintf(s.replay.dump, "%v %s %s\n", s.tickCount, strings.Join(to, ","), data)
+	}
+}
+
+// readConfig rereads the config file. A replay gets the config read when the session was recorded
+func (s *mmoServer) readConfig() (*config, error) {
+	if s.replay != nil {
+		e := s.replay.nextReload()
+		return e.Config, e.err()
+	}
+	cfg, err := loadConfig(s.configPath)
+	s.recordEvent(&event{Reload: &reloadEvent{Config: cfg, Err: errString(err)}})
+	return cfg, err
+}
+
+// reloadBans rereads the ban file. A replay gets the bans read when the session was recorded
+func (s *mmoServer) reloadBans() error {
+	if s.replay != nil {
+		e := s.replay.nextReload()
+		if e.Err == "" {
+			s.bans.replace(e.Bans)
+		}
+		return e.err()
+	}
+	bans, err := s.bans.read()
+	s.recordEvent(&event{Reload: &reloadEvent{Bans: bans, Err: errString(err)}})
+	if err != nil {
+		return err
+	}
+	s.bans.replace(bans)
+	return nil
+}
+
+// replay is the state of a server replaying a recording
+type replay struct {
+	reloads []*reloadEvent // file reads still to come this tick
+	dump    io.Writer      // where to write the messages sent, if anywhere
+}
+
+func (r *replay) nextReload() *reloadEvent {
+	if len(r.reloads) == 0 {
+		return &reloadEvent{Err: "the recording has no file read here"}
+	}
+	e := r.reloads[0]
+	r.reloads = r.reloads[1:]
+	return e
+}
+
+// replaySession reruns a recorded session on a new server, checking that every tick sends
+// the same messages it did when recorded. Each message sent is written to dump if it is not nil.
+// Nothing is written to the data, ban or audit files
+func replaySession(path string, dump io.Writer) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	zip, err := gzip.NewReader(f)
+	if err != nil {
+		return "", fmt.Errorf("reading %s: %v", path, err)
+	}
+	dec := gob.NewDecoder(zip)
+	var header recordingHeader
+	if err := dec.Decode(&header); err != nil {
+		return "", fmt.Errorf("reading %s: %v", path, err)
+	}
+	if header.Version != recordingVersion {
+		return "", fmt.Errorf("%s is a version %v recording, this server replays version %v", path, header.Version, recordingVersion)
+	}
+
+	dir, err := ioutil.TempDir("", "mmo-replay")
+	if err != nil {
+		return "", err
+	}
+	defer os.RemoveAll(dir)
+	store, err := newPlayerStore(filepath.Join(dir, "data"))
+	if err != nil {
+		return "", err
+	}
+	defer store.Close()
+	bans := &banList{path: filepath.Join(dir, "bans.json"), bans: header.Bans}
+	var filter *wordFilter
+	if header.WordFilter != "" {
+		filter = &wordFilter{pattern: regexp.MustCompile(header.WordFilter)}
+	}
+	s := newMMOServer(header.Config, "", store, header.Spells, header.NPCs, header.Progression, filter,
+		header.Moderators, header.Admins, nil, bans, header.Seed)
+	s.replay = &replay{dump: dump}
+	if s.worldDigest() != header.World {
+		return "", fmt.Errorf("the world rebuilt from %s differs from the one recorded", path)
+	}
+
+	sent := 0
+	for {
+		start, events, end, err := readTick(dec)
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			// recordings end wherever the server stopped, maybe part way through a tick
+			break
+		}
+		if err != nil {
+			return "", fmt.Errorf("reading %s: %v", path, err)
+		}
+		s.startTick(start.Time)
+		if s.tickCount != start.Tick {
+			return "", fmt.Errorf("recorded tick %v follows tick %v", start.Tick, s.tickCount-1)
+		}
+		s.replay.reloads = nil
+		for _, e := range events {
+			if e.Reload != nil {
+				s.replay.reloads = append(s.replay.reloads, e.Reload)
+			}
+		}
+		for _, e := range events {
+			s.replayEvent(e)
+		}
+		if err := s.finishTick(); err != nil {
+			tickLog.Error("tick failed", "tick", s.tickCount, "err", err)
+		}
+		if s.digest.sent != end.Sent || s.digest.sum() != end.Digest {
+			return "", fmt.Errorf("tick %v sent %v messages which differ from the %v recorded", s.tickCount, s.digest.sent, end.Sent)
+		}
+		sent += end.Sent
+	}
+	return fmt.Sprintf("replayed %v ticks from %s, all %v messages sent matched", s.tickCount, header.Started.Format(time.RFC1123), sent), nil
+}
+
+// readTick reads the entries of the next tick of a recording
+func readTick(dec *gob.Decoder) (*tickStart, []*event, *tickEnd, error) {
+	var e entry
+	if err := dec.Decode(&e); err != nil {
+		return nil, nil, nil, err
+	}
+	if e.Start == nil {
+		return nil, nil, nil, fmt.Errorf("expected the start of a tick")
+	}
+	start := e.Start
+	events := []*event{}
+	for {
+		var e entry
+		if err := dec.Decode(&e); err != nil {
+			return nil, nil, nil, err
+		}
+		switch {
+		case e.Event != nil:
+			events = append(events, e.Event)
+		case e.End != nil:
+			return start, events, e.End, nil
+		default:
+			return nil, nil, nil, fmt.Errorf("tick %v did not end", start.Tick)
+		}
+	}
+}
+
+// replayEvent feeds a recorded input to the game loop
+func (s *mmoServer) replayEvent(e *event) {
+	switch {
+	case e.Join != nil:
+		s.join(&join{id: e.Join.ID, conn: &replayConn{addr: replayAddr(e.Join.Addr)}, record: e.Join.Record})
+	case e.Leave != "":
+		s.leave(e.Leave)
+	case e.Admin != nil:
+		s.runAdminCommand(e.Admin.Source, e.Admin.Line)
+	case e.Request != nil:
+		s.handleRequest(e.Request.ID, e.Request.Request)
+	}
+}
+
+// replayConn stands in for the connection of a replayed player. What is written to it is thrown away
+type replayConn struct {
+	addr replayAddr
+}
+
+func (c *replayConn) Read(b []byte) (int, error)         { return 0, io.EOF }
+func (c *replayConn) Write(b []byte) (int, error)        { return len(b), nil }
+func (c *replayConn) Close() error                       { return nil }
+func (c *replayConn) LocalAddr() net.Addr                { return replayAddr("replay") }
+func (c *replayConn) RemoteAddr() net.Addr               { return c.addr }
+func (c *replayConn) SetDeadline(t time.Time) error      { return nil }
+func (c *replayConn) SetReadDeadline(t time.Time) error  { return nil }
+func (c *replayConn) SetWriteDeadline(t time.Time) error { return nil }
+
+// replayAddr is the address a replayed player connected from
+type replayAddr string
+
+func (a replayAddr) Network() string { return "replay" }
+func (a replayAddr) String() string  { return string(a) }
@@ -0,0 +1,236 @@
+package main
+
+import (
+	"compress/gzip"
+	"encoding/gob"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/faiface/pixel"
+	"github.com/mmogo/mmo/shared"
+)
+
+// the recording has to be made again whenever the game changes what it sends, with
+//
+//	go test -run TestReplaySession -update
+var update = flag.Bool("update", false, "record testdata/session.rec again before replaying it")
+
+const sessionRecording = "testdata/session.rec"
+
+func TestReplaySession(t *testing.T) {
+	if *update {
+		if err := recordSession(sessionRecording); err != nil {
+			t.Fatalf("recording: %v", err)
+		}
+	}
+	result, err := replaySession(sessionRecording, nil)
+	if err != nil {
+		t.Fatalf("replaying %s: %v", sessionRecording, err)
+	}
+	t.Log(result)
+}
+
+func TestReplayDetectsChanges(t *testing.T) {
+	dir, err := ioutil.TempDir("", "mmo-replay-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "session.rec")
+
+	// a tick which sent something else when recorded must fail the replay
+	changed := false
+	err = rewriteRecording(sessionRecording, path, func(e *entry) {
+		if e.End != nil && e.End.Sent > 0 && !changed {
+			e.End.Digest++
+			changed = true
+		}
+	})
+	if err != nil {
+		t.Fatalf("rewriting %s: %v", sessionRecording, err)
+	}
+	if _, err := replaySession(path, nil); err == nil {
+		t.Error("replay of a tick with a different digest matched")
+	}
+}
+
+// rewriteRecording copies a recording, passing each entry after the header through change
+func rewriteRecording(from, to string, change func(e *entry)) error {
+	in, err := os.Open(from)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	unzip, err := gzip.NewReader(in)
+	if err != nil {
+		return err
+	}
+	out, err := os.Create(to)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+	zip := gzip.NewWriter(out)
+	dec, enc := gob.NewDecoder(unzip), gob.NewEncoder(zip)
+	var header recordingHeader
+	if err := dec.Decode(&header); err != nil {
+		return err
+	}
+	if err := enc.Encode(&header); err != nil {
+		return err
+	}
+	for {
+		var e entry
+		if err := dec.Decode(&e); err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+		change(&e)
+		if err := enc.Encode(&e); err != nil {
+			return err
+		}
+	}
+	return zip.Close()
+}
+
+// recordSession records a short scripted session of an admin and another player
+// walking, chatting, shooting and leaving
+func recordSession(path string) error {
+	dir, err := ioutil.TempDir("", "mmo-record")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+	store, err := newPlayerStore(filepath.Join(dir, "data"))
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+	cfg, err := loadConfig("")
+	if err != nil {
+		return err
+	}
+	bans := &banList{path: filepath.Join(dir, "bans.json")}
+	s := newMMOServer(cfg, "", store, shared.DefaultSpells, defaultNPCDefinitions, shared.DefaultProgression, nil,
+		nil, []string{"alice"}, nil, bans, 1)
+	if err := s.startRecording(path, defaultNPCDefinitions); err != nil {
+		return err
+	}
+
+	conns := []*testConn{}
+	defer func() {
+		for _, conn := range conns {
+			conn.Close()
+		}
+	}()
+	request := func(id string, req *shared.Request) {
+		s.playersLock.RLock()
+		player := s.players[id]
+		s.playersLock.RUnlock()
+		player.QueueLock.Lock()
+		player.RequestQueue = append(player.RequestQueue, &shared.Message{Request: req})
+		player.QueueLock.Unlock()
+	}
+	speak := func(id, text string) {
+		request(id, &shared.Request{SpeakRequest: &shared.SpeakRequest{Text: text}})
+	}
+	move := func(id string, direction pixel.Vec) {
+		request(id, &shared.Request{MoveRequest: &shared.MoveRequest{Direction: direction, Created: s.now}})
+	}
+	script := []func() error{
+		func() error {
+			for i, id := range []string{"alice", "bob"} {
+				record, err := store.Load(id)
+				if err != nil {
+					return err
+				}
+				conn := newTestConn(fmt.Sprintf("10.0.0.%v:5000", i+1))
+				conns = append(conns, conn)
+				s.joins <- &join{id: id, conn: conn, record: record}
+			}
+			return nil
+		},
+		func() error {
+			move("bob", pixel.V(1, 0))
+			speak("alice", "hello")
+			return nil
+		},
+		func() error {
+			move("bob", pixel.V(1, 0))
+			// round trip times aren't recorded, so they mustn't change what is sent
+			s.playersLock.RLock()
+			atomic.StoreInt64(&s.players["alice"].RTT, int64(50*time.Millisecond))
+			s.playersLock.RUnlock()
+			return nil
+		},
+		func() error {
+			speak("alice", "/players")
+			request("bob", &shared.Request{ShootRequest: &shared.ShootRequest{Direction: pixel.V(0, 1), Created: s.now}})
+			return nil
+		},
+		func() error {
+			speak("bob", "/w alice hi")
+			return nil
+		},
+		func() error { return nil },
+		func() error {
+			s.leaves <- "bob"
+			return nil
+		},
+		func() error { return nil },
+	}
+	for _, step := range script {
+		if err := step(); err != nil {
+			return err
+		}
+		if err := s.tick(); err != nil {
+			return err
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	r := s.recorder
+	if r.err != nil {
+		return r.err
+	}
+	if err := r.zip.Close(); err != nil {
+		return err
+	}
+	return r.file.Close()
+}
+
+// testConn is the connection of a player in a scripted session. Reading from it
+// waits until it is closed, and what is written to it is counted and thrown away
+type testConn struct {
+	replayConn
+	closed chan struct{}
+	once   sync.Once
+	writes int64
+}
+
+func newTestConn(addr string) *testConn {
+	return &testConn{replayConn: replayConn{addr: replayAddr(addr)}, closed: make(chan struct{})}
+}
+
+func (c *testConn) Read(b []byte) (int, error) {
+	<-c.closed
+	return 0, io.EOF
+}
+
+func (c *testConn) Write(b []byte) (int, error) {
+	atomic.AddInt64(&c.writes, 1)
+	return len(b), nil
+}
+
+func (c *testConn) Close() error {
+	c.once.Do(func() { close(c.closed) })
+	return nil
+}
@@ -2,11 +2,14 @@ package main
 
 import (
 	"sync"
+	"sync/atomic"
 
 	"crypto/md5"
 	"fmt"
 	"io"
 	"log"
+	"math"
+	"math/rand"
 	"net"
 	"net/http"
 	"os"
@@ -21,26 +24,122 @@ import (
 )
 
 type mmoServer struct {
+	configLock  sync.RWMutex
+	config      *config
+	configPath  string
 	playersLock sync.RWMutex
 	players     map[string]*shared.ServerPlayer
 	updatesLock sync.Mutex
 	updates     []func() error
+	tileMap     *shared.TileMap
+	spells      map[string]*shared.Spell
+	spellList   []*shared.Spell
+	store       *playerStore
+	accounts    *accountStore       // nil when replaying
+	open        bool                // anyone can connect as any player, without logging in
+	servers     []shared.ServerInfo // listed to the patcher, or just this server if empty
+	accountsTLS string              // address the account endpoints are served on over TLS, or empty to serve them on the game port
+	progression *shared.Progression
+
+	partiesLock  sync.Mutex
+	parties      map[string]*party // by member ID
+	partyInvites map[string]string // inviter by invited player ID
+
+	chatLock       sync.Mutex
+	chatAllowances map[string]*chatAllowance
+	mutes          map[string]time.Time // when each mute ends
+	wordFilter     *wordFilter
+	moderators     map[string]bool
+
+	admins        map[string]bool
+	audit         *log.Logger
+	adminRequests chan *adminRequest
+	bans          *banList
+
+	joins  chan *join  // players waiting to enter the world
+	leaves chan string // IDs of players who disconnected
+
+	// only accessed from the game loop
+	entities    map[string]*shared.Entity // entities other than players
+	entityCount int
+	npcs        map[string]*npc
+	seed        int64
+	rand        *rand.Rand
+	tickTime    float64   // seconds
+	tickCount   uint64    // ticks run so far
+	now         time.Time // the time of the current tick, which the simulation uses in place of time.Now
+	lastPing    time.Time
+	lastSave    time.Time
+	recorder    *recorder // nil unless recording
+	replay      *replay   // nil unless replaying
+	digest      *digest   // of the messages sent this tick, nil unless recording or replaying
+
+	metrics *metrics
 }
 
-func newMMOServer() *mmoServer {
-	return &mmoServer{
-		players: make(map[string]*shared.ServerPlayer),
-		updates: []func() error{},
+// join is a player who has connected and been loaded, ready to enter the world
+type join struct {
+	id     string
+	conn   net.Conn
+	record *playerRecord
+}
+
+func newMMOServer(cfg *config, configPath string, store *playerStore, spells []*shared.Spell, npcDefs *npcDefinitions, progression *shared.Progression,
+	filter *wordFilter, moderators, admins []string, audit *log.Logger, bans *banList, seed int64) *mmoServer {
+	s := &mmoServer{
+		configPath:     configPath,
+		players:        make(map[string]*shared.ServerPlayer),
+		updates:        []func() error{},
+		tileMap:        shared.DefaultTileMap(),
+		spells:         make(map[string]*shared.Spell),
+		spellList:      spells,
+		store:          store,
+		progression:    progression,
+		parties:        make(map[string]*party),
+		partyInvites:   make(map[string]string),
+		chatAllowances: make(map[string]*chatAllowance),
+		mutes:          make(map[string]time.Time),
+		wordFilter:     filter,
+		moderators:     make(map[string]bool),
+		admins:         make(map[string]bool),
+		audit:          audit,
+		adminRequests:  make(chan *adminRequest, 16),
+		bans:           bans,
+		joins:          make(chan *join, 16),
+		leaves:         make(chan string, 16),
+		entities:       make(map[string]*shared.Entity),
+		npcs:           make(map[string]*npc),
+		seed:           seed,
+		rand:           rand.New(rand.NewSource(seed)),
+		now:            time.Now(),
+		metrics:        newMetrics(),
+	}
+	for _, spell := range spells {
+		s.spells[spell.Name] = spell
+	}
+	for _, id := range moderators {
+		if id != "" {
+			s.moderators[strings.ToLower(id)] = true
+		}
+	}
+	for _, id := range admins {
+		if id != "" {
+			s.admins[strings.ToLower(id)] = true
+		}
 	}
+	s.applyConfig(cfg)
+	s.spawnItem("gold", 10, pixel.ZV)
+	s.spawnItem("dagger", 1, pixel.V(shared.TileSize, 0))
+	s.spawnNPCs(npcDefs)
+	return s
 }
 
 func (s *mmoServer) start(protocol string, port int, errc chan error) error {
 	laddr := fmt.Sprintf(":%v", port)
 	//get client checksums
-	clientChecksums := map[string]string{
-		"client-windows-4.0-amd64.exe": "",
-		"client-darwin-10.6-amd64":     "",
-		"client-linux-amd64":           "",
+	clientChecksums := make(map[string]string)
+	for _, client := range s.cfg().Clients {
+		clientChecksums[client] = ""
 	}
 	//requires clients to be in same dir as server
 	for client := range clientChecksums {
@@ -53,10 +152,18 @@ func (s *mmoServer) start(protocol string, port int, errc chan error) error {
 			return err
 		}
 		clientChecksums[client] = string(h.Sum(nil))
-		log.Printf("serving client: %s", client)
+		serverLog.Info("serving client", "file", client)
 	}
 
 	mux := http.NewServeMux()
+	mux.HandleFunc("/metrics", s.serveMetrics)
+	mux.HandleFunc(shared.ServersPath, s.serveServers)
+	if s.accounts != nil && s.accountsTLS == "" {
+		if !s.open {
+			accountLog.Warn("account endpoints are served over plain http on the game port. put them behind a TLS proxy, or use -accounts-addr")
+		}
+		s.accounts.serve(mux)
+	}
 	mux.HandleFunc("/", func(w http.ResponseWriter, req *http.Request) {
 		if req.Method != "GET" {
 			w.WriteHeader(http.StatusNotFound)
@@ -68,13 +175,13 @@ func (s *mmoServer) start(protocol string, port int, errc chan error) error {
 				return
 			}
 			if strings.Contains(req.URL.Path, client) {
-				log.Printf("serving client: %s", client)
+				netLog.Info("sending client", "file", client, "to", req.RemoteAddr)
 				http.ServeFile(w, req, client)
 				return
 			}
 
 		}
-		log.Printf("bad http request: %s", req.URL.Path)
+		netLog.Warn("bad http request", "path", req.URL.Path, "from", req.RemoteAddr)
 		http.NotFound(w, req)
 
 	})
@@ -94,18 +201,18 @@ func (s *mmoServer) start(protocol string, port int, errc chan error) error {
 		}
 		go func() {
 			go m.Serve()
-			log.Printf("HTTP server crashed: %v", httpServer.Serve(httpL))
+			serverLog.Error("HTTP server crashed", "err", httpServer.Serve(httpL))
 		}()
 	} else {
 		go func() {
-			log.Printf("fileserver crashed: %v", http.ListenAndServe(laddr, mux))
+			serverLog.Error("fileserver crashed", "err", http.ListenAndServe(laddr, mux))
 		}()
 	}
 
 	// start game loop
 	go s.gameLoop(errc)
 
-	log.Printf("listening for connections on %v", port)
+	serverLog.Info("listening for connections", "port", port, "protocol", protocol)
 	for {
 		conn, err := l.Accept()
 		if err != nil {
@@ -129,8 +236,9 @@ func (s *mmoServer) handleConnection(conn net.Conn) error {
 	if err != nil {
 		return err
 	}
+	ip := remoteIP(conn)
 
-	conn = stream
+	conn = &countingConn{Conn: stream, metrics: s.metrics}
 
 	// read message
 	msg, err := shared.GetMessage(conn, true)
@@ -146,8 +254,23 @@ func (s *mmoServer) handleConnection(conn net.Conn) error {
 	// get ID
 	id := msg.Request.ConnectRequest.ID
 
-	// check if in use
-	if _, taken := s.players[id]; taken {
+	// characters can only be played by logging in to their account
+	if !s.open {
+		owns, err := s.accounts.Owns(msg.Request.ConnectRequest.Token, id)
+		if err != nil {
+			return errors.New("checking the account of "+id, err)
+		}
+		if !owns {
+			err := fmt.Errorf("not logged in to the account of %q", id)
+			if err := s.sendError(conn, shared.FatalErr(err)); err != nil {
+				return shared.FatalErr(err)
+			}
+			return err
+		}
+	}
+
+	// check if in use. join checks again in case they connect twice at once
+	if s.online(id) {
 		err := fmt.Errorf("Player ID %q in use", id)
 		if err := s.sendError(conn, shared.FatalErr(err)); err != nil {
 			return shared.FatalErr(err)
@@ -155,20 +278,90 @@ func (s *mmoServer) handleConnection(conn net.Conn) error {
 		return err
 	}
 
-	pos := pixel.ZV
+	// refuse banned players before they get into the world
+	if b := s.bans.Check(id, ip); b != nil {
+		if err := s.sendError(conn, shared.FatalErr(b)); err != nil {
+			return shared.FatalErr(err)
+		}
+		return fmt.Errorf("refused %s from %s: %v", id, ip, b)
+	}
+
+	record, err := s.store.Load(id)
+	if err != nil {
+		return errors.New("loading player "+id, err)
+	}
+	// IDs are case insensitive, but the client must know the player as the game does
+	if record.ID != id {
+		err := fmt.Errorf("the player %q is called %q, connect with that ID", id, record.ID)
+		if err := s.sendError(conn, shared.FatalErr(err)); err != nil {
+			return shared.FatalErr(err)
+		}
+		return err
+	}
+
+	// the game loop puts them in the world at the start of the next tick
+	s.joins <- &join{id: id, conn: conn, record: record}
+	return nil
+}
+
+// runJoins puts the players who connected since the last tick into the world
+func (s *mmoServer) runJoins() {
+	for {
+		select {
+		case j := <-s.joins:
+			s.recordEvent(&event{Join: &joinEvent{ID: j.id, Addr: j.conn.RemoteAddr().String(), Record: j.record}})
+			s.join(j)
+		default:
+			return
+		}
+	}
+}
+
+// join adds a loaded player to the world and sends them everything they need to start playing
+func (s *mmoServer) join(j *join) {
+	id, conn, record := j.id, j.conn, j.record
+	if s.online(id) {
+		s.sendError(conn, shared.FatalErr(fmt.Errorf("Player ID %q in use", id)))
+		conn.Close()
+		return
+	}
+
+	pos := record.Position
+	stats := record.Stats
+	if stats == nil {
+		stats = s.progression.NewStats()
+	}
+	// the progression may have changed since they last played
+	s.progression.Apply(stats)
+	if stats.Health <= 0 {
+		// saved dead, before the dead were saved as respawned
+		stats.Health, stats.Mana = stats.MaxHealth, stats.MaxMana
+		pos = playerSpawn
+	}
 	s.playersLock.Lock()
 	defer s.playersLock.Unlock()
-	s.players[id] = &shared.ServerPlayer{
-		Player: &shared.Player{
-			ID:       id,
-			Position: pos,
+	player := &shared.ServerPlayer{
+		Entity: &shared.Entity{
+			ID:         id,
+			Kind:       shared.E_PLAYER,
+			Position:   pos,
+			Facing:     shared.DOWN,
+			Action:     shared.A_IDLE,
+			Level:      stats.Level,
+			Equipment:  record.Equipment,
+			Appearance: record.Appearance,
 		},
-		Conn: conn,
+		Conn:      conn,
+		Stats:     stats,
+		Cooldowns: make(map[string]time.Time),
+		Inventory: record.Inventory,
 	}
+	s.players[id] = player
 
-	// move to (0,0)
+	// spawn where they left off
+	spawned := player.Entity.Copy()
 	s.queueUpdate(func() error {
-		return s.broadcastPlayerMoved(id, pos, time.Now())
+		return s.broadcastEntitySpawned(spawned)
 	})
 
 	// send world state to player
@@ -176,32 +369,49 @@ func (s *mmoServer) handleConnection(conn net.Conn) error {
 		return s.sendWorldState(id)
 	})
 
-	// handle player in goroutine
-	go s.handlePlayer(id)
+	s.queueUpdate(func() error {
+		return s.sendGameSettings(id)
+	})
+	s.queueUpdate(func() error {
+		return s.sendSpellBook(id)
+	})
+	s.queueUpdate(func() error {
+		return s.sendPlayerResources(id)
+	})
+	s.queueUpdate(func() error {
+		return s.sendInventory(id)
+	})
 
-	log.Printf("new connected player %s from %s", id, conn.RemoteAddr().String())
-	return nil
+	// replayed players have no connection to read from
+	if s.replay == nil {
+		go s.handlePlayer(id, player)
+	}
+
+	netLog.Info("player connected", "player", id, "from", conn.RemoteAddr())
 }
 
-func (s *mmoServer) handlePlayer(id string) {
-	for s.players[id] != nil {
-		player := s.players[id]
-		for len(player.RequestQueue) >= messagePerTickLimit {
+// handlePlayer reads the player's requests until they disconnect
+func (s *mmoServer) handlePlayer(id string, player *shared.ServerPlayer) {
+	for {
+		for len(player.RequestQueue) >= s.cfg().MessagePerTickLimit {
 			time.Sleep(time.Millisecond)
 		}
 		conn := player.Conn
 		msg, err := shared.GetMessage(conn, true)
+		if shared.IsDecodeError(err) {
+			atomic.AddUint64(&s.metrics.decodeErrors, 1)
+		}
 		if err != nil {
-			log.Print(errors.New(fmt.Sprintf("Client disconnected: (failed getting message for player %s)", id), err))
-			s.playersLock.Lock()
-			delete(s.players, id)
-			s.playersLock.Unlock()
-			s.queueUpdate(func() error {
-				return s.broadcastPlayerDisconnected(id)
-			})
+			netLog.Info("player disconnected", "player", id, "err", err)
+			s.leaves <- id
+			return
+		}
+		netLog.Debug("received", "player", id, "msg", msg)
+		s.metrics.messageReceived(msg.Type())
+		if msg.Request != nil && msg.Request.PongRequest != nil {
+			atomic.StoreInt64(&player.RTT, int64(time.Since(msg.Request.PongRequest.Sent)))
 			continue
 		}
-		log.Printf("%s %q", msg, id)
 		if msg.Request != nil {
 			player.QueueLock.Lock()
 			player.RequestQueue = append(player.RequestQueue, msg)
@@ -210,93 +420,278 @@ func (s *mmoServer) handlePlayer(id string) {
 	}
 }
 
+// runLeaves takes the players who disconnected since the last tick out of the world
+func (s *mmoServer) runLeaves() {
+	for {
+		select {
+		case id := <-s.leaves:
+			s.recordEvent(&event{Leave: id})
+			s.leave(id)
+		default:
+			return
+		}
+	}
+}
+
+func (s *mmoServer) leave(id string) {
+	s.playersLock.Lock()
+	player, ok := s.players[id]
+	if ok {
+		// queued before they go offline, so reconnecting loads this save
+		if err := s.savePlayer(player); err != nil {
+			serverLog.Error("saving player failed", "player", id, "err", err)
+		}
+	}
+	delete(s.players, id)
+	s.playersLock.Unlock()
+	if !ok {
+		return
+	}
+	s.leaveParty(id)
+	s.forgetChat(id)
+	s.queueUpdate(func() error {
+		return s.broadcastEntityRemoved(id, "")
+	})
+}
+
 func (s *mmoServer) gameLoop(errc chan error) {
 	last := time.Now()
 	dt := 0.0
 	for {
 		dt += time.Since(last).Seconds()
 		last = time.Now()
-		if dt < tickTime {
-			sleepTime := time.Duration(1000000*(tickTime-dt)) * time.Microsecond
+		if dt < s.tickTime {
+			sleepTime := time.Duration(1000000*(s.tickTime-dt)) * time.Microsecond
 			time.Sleep(sleepTime)
 		}
 		dt = 0.0
-		if err := s.tick(); err != nil {
-			log.Printf("ERROR IN TICK: %v", err)
+		start := time.Now()
+		err := s.tick()
+		took := time.Since(start)
+		s.metrics.observeTick(took, s.tickTime)
+		if took.Seconds() > s.tickTime {
+			tickLog.Warn("tick overran", "took", took, "tick", s.tickTime)
+		}
+		if err != nil {
+			tickLog.Error("tick failed", "err", err)
 			errc <- err
 		}
 	}
 }
 
+// tick runs the game for one tick. Everything from outside the game loop which changes
+// the world, such as players joining and their requests, is taken in here at the start
+// of the tick, in order, so that a recording of it can be replayed exactly
 func (s *mmoServer) tick() error {
-	for id, player := range s.players {
+	s.startTick(time.Now())
+	s.runJoins()
+	s.runLeaves()
+	s.runAdminRequests()
+	s.playersLock.RLock()
+	ids := sortedKeys(s.players)
+	s.playersLock.RUnlock()
+	for _, id := range ids {
+		s.playersLock.RLock()
+		player, ok := s.players[id]
+		s.playersLock.RUnlock()
+		if !ok {
+			continue
+		}
 		player.QueueLock.Lock()
-		for _, msg := range player.RequestQueue {
+		requests := player.RequestQueue
+		player.RequestQueue = []*shared.Message{}
+		player.QueueLock.Unlock()
+		for _, msg := range requests {
 			if msg.Request != nil {
-				switch {
-				case msg.Request.MoveRequest != nil:
-					s.handleMoveRequest(id, msg.Request.MoveRequest)
-				case msg.Request.SpeakRequest != nil:
-					s.handleSpeakRequest(id, msg.Request.SpeakRequest)
-				}
+				s.recordEvent(&event{Request: &requestEvent{ID: id, Request: msg.Request}})
+				s.handleRequest(id, msg.Request)
 			}
 		}
-		player.RequestQueue = []*shared.Message{}
-		player.QueueLock.Unlock()
 	}
+	return s.finishTick()
+}
 
+// startTick begins a tick at the given time
+func (s *mmoServer) startTick(now time.Time) {
+	s.tickCount++
+	s.now = now
+	if s.recorder != nil {
+		s.recorder.startTick(s.tickCount, now)
+	}
+	if s.recorder != nil || s.replay != nil {
+		s.digest = newDigest()
+	}
+}
+
+// finishTick runs the simulation for the tick and sends the updates it queued
+func (s *mmoServer) finishTick() error {
+	s.updateEntities()
+	s.respawnNPCs()
+	s.respawnPlayers()
+	s.updateCasts()
+	s.pingPlayers()
+	if s.now.Sub(s.lastSave) >= s.cfg().saveInterval() {
+		s.saveOnline()
+	}
+	err := s.runUpdates()
+	if s.recorder != nil {
+		if err := s.recorder.finishTick(s.digest); err != nil {
+			serverLog.Error("recording failed, recording stopped", "err", err)
+			s.recorder = nil
+			s.digest = nil
+		}
+	}
+	return err
+}
+
+func (s *mmoServer) handleRequest(id string, req *shared.Request) {
+	// the dead can only talk until they respawn
+	if req.SpeakRequest == nil && s.isDead(id) {
+		return
+	}
+	switch {
+	case req.MoveRequest != nil:
+		s.handleMoveRequest(id, req.MoveRequest)
+	case req.SpeakRequest != nil:
+		s.handleSpeakRequest(id, req.SpeakRequest)
+	case req.ShootRequest != nil:
+		s.handleShootRequest(id, req.ShootRequest)
+	case req.CastRequest != nil:
+		s.handleCastRequest(id, req.CastRequest)
+	case req.PickupRequest != nil:
+		s.handlePickupRequest(id, req.PickupRequest)
+	case req.EquipRequest != nil:
+		s.handleEquipRequest(id, req.EquipRequest)
+	case req.UnequipRequest != nil:
+		s.handleUnequipRequest(id, req.UnequipRequest)
+	}
+}
+
+// runUpdates sends the updates queued during the tick. One which fails doesn't stop
+// the rest, and the first failure is returned
+func (s *mmoServer) runUpdates() error {
 	s.updatesLock.Lock()
 	defer s.updatesLock.Unlock()
-	processed := 0
+	var first error
 	for _, update := range s.updates {
-		if err := update(); err != nil {
-			return errors.New("processing update", err)
+		if err := update(); err != nil && first == nil {
+			first = errors.New("processing update", err)
 		}
-		processed++
 	}
-	s.updates = s.updates[processed:]
-	return nil
+	s.updates = []func() error{}
+	return first
+}
+
+func (s *mmoServer) broadcastEntitySpawned(entity *shared.Entity) error {
+	entitySpawned := &shared.Message{
+		Update: &shared.Update{EntitySpawned: &shared.EntitySpawned{Entity: entity}},
+	}
+	return s.broadcast(entitySpawned)
 }
 
-func (s *mmoServer) broadcastPlayerMoved(id string, newPos pixel.Vec, requestTime time.Time) error {
-	playerMoved := &shared.Message{
-		Update: &shared.Update{PlayerMoved: &shared.PlayerMoved{
-			ID:          id,
-			NewPosition: newPos,
+func (s *mmoServer) broadcastEntityMoved(entity *shared.Entity, requestTime time.Time) error {
+	entityMoved := &shared.Message{
+		Update: &shared.Update{EntityMoved: &shared.EntityMoved{
+			ID:          entity.ID,
+			NewPosition: entity.Position,
+			Facing:      entity.Facing,
+			Action:      entity.Action,
 			RequestTime: requestTime,
 		}},
 	}
-	return s.broadcast(playerMoved)
+	return s.broadcast(entityMoved)
 }
 
-func (s *mmoServer) broadcastPlayerSpoke(id string, txt string) error {
-	playerSpoke := &shared.Message{
-		Update: &shared.Update{PlayerSpoke: &shared.PlayerSpoke{
-			ID:   id,
-			Text: txt,
+func (s *mmoServer) broadcastEntityRemoved(id, hitID string) error {
+	entityRemoved := &shared.Message{
+		Update: &shared.Update{EntityRemoved: &shared.EntityRemoved{
+			ID:    id,
+			HitID: hitID,
 		}},
 	}
-	return s.broadcast(playerSpoke)
+	return s.broadcast(entityRemoved)
 }
 
 func (s *mmoServer) sendWorldState(id string) error {
 	s.playersLock.RLock()
-	ps := make([]*shared.Player, len(s.players))
-	i := 0
-	for _, player := range s.players {
-		ps[i] = &shared.Player{
-			ID:       player.ID,
-			Position: player.Position,
+	entities := make([]*shared.Entity, 0, len(s.players)+len(s.entities))
+	for _, playerID := range sortedKeys(s.players) {
+		entities = append(entities, s.players[playerID].Entity.Copy())
+	}
+	_, ok := s.players[id]
+	s.playersLock.RUnlock()
+	if !ok {
+		return errors.New("player "+id+" not found", nil)
+	}
+	for _, entityID := range sortedKeys(s.entities) {
+		entities = append(entities, s.entities[entityID].Copy())
+	}
+	parts, err := worldStateParts(entities)
+	if err != nil {
+		return err
+	}
+	for i, part := range parts {
+		err := s.send(id, &shared.Message{
+			Update: &shared.Update{WorldState: &shared.WorldState{Entities: part, Part: i, Parts: len(parts)}}})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// maxWorldStatePart is how many bytes of encoded entities go in each part of the world
+// state, leaving room under shared.SendRaw's limit for the rest of the message
+const maxWorldStatePart = 48 * 1024
+
+// worldStateParts splits the entities of the world state into parts small enough to send
+func worldStateParts(entities []*shared.Entity) ([][]*shared.Entity, error) {
+	parts := [][]*shared.Entity{{}}
+	size := 0
+	for _, entity := range entities {
+		data, err := shared.Encode(entity)
+		if err != nil {
+			return nil, err
+		}
+		last := len(parts) - 1
+		if size+len(data) > maxWorldStatePart && len(parts[last]) > 0 {
+			parts = append(parts, []*shared.Entity{})
+			last, size = last+1, 0
 		}
-		i++
+		parts[last] = append(parts[last], entity)
+		size += len(data)
 	}
+	return parts, nil
+}
+
+// send delivers a message to a single player. Players who have since disconnected are skipped
+func (s *mmoServer) send(id string, msg *shared.Message) error {
+	s.playersLock.RLock()
 	player, ok := s.players[id]
 	s.playersLock.RUnlock()
 	if !ok {
-		return errors.New("player "+id+" not found", nil)
+		return nil
+	}
+	msg.Sent = s.now
+	data, err := shared.Encode(msg)
+	if err != nil {
+		atomic.AddUint64(&s.metrics.encodeErrors, 1)
+		return err
+	}
+	s.metrics.messageSent(msg.Type(), 1)
+	s.recordSent([]string{id}, msg)
+	s.sendRaw(id, player.Conn, data)
+	return nil
+}
+
+// sendRaw writes an encoded message to a player. A connection which fails is closed,
+// which makes the player leave, rather than the failure holding up the messages to
+// everyone else. The digest is of what the game sends, whether or not it arrives
+func (s *mmoServer) sendRaw(id string, conn net.Conn, data []byte) {
+	if err := shared.SendRaw(data, conn); err != nil {
+		netLog.Warn("sending failed, disconnecting", "player", id, "from", conn.RemoteAddr(), "err", err)
+		conn.Close()
 	}
-	return shared.SendMessage(&shared.Message{
-		Update: &shared.Update{WorldState: &shared.WorldState{Players: ps}}}, player.Conn)
 }
 
 func (s *mmoServer) sendError(conn net.Conn, err error) error {
@@ -307,27 +702,22 @@ func (s *mmoServer) sendError(conn net.Conn, err error) error {
 		Error: &shared.Error{Message: err.Error()}}, conn)
 }
 
-func (s *mmoServer) broadcastPlayerDisconnected(id string) error {
-	playerDisconnected := &shared.Message{
-		Update: &shared.Update{PlayerDisconnected: &shared.PlayerDisconnected{ID: id}},
-	}
-	return s.broadcast(playerDisconnected)
-}
-
 func (s *mmoServer) broadcast(msg *shared.Message) error {
-	log.Println(msg)
+	netLog.Debug("broadcasting", "msg", msg)
 	data, err := shared.Encode(msg)
 	if err != nil {
+		atomic.AddUint64(&s.metrics.encodeErrors, 1)
 		return err
 	}
 	s.playersLock.RLock()
 	defer s.playersLock.RUnlock()
-	for _, player := range s.players {
-		log.Printf("sending update: %s to %s", msg, player.Player.ID)
-		player.Conn.SetDeadline(time.Now().Add(time.Second))
-		if err := shared.SendRaw(data, player.Conn); err != nil {
-			return err
-		}
+	ids := sortedKeys(s.players)
+	s.metrics.messageSent(msg.Type(), len(ids))
+	s.recordSent(ids, msg)
+	for _, id := range ids {
+		conn := s.players[id].Conn
+		conn.SetDeadline(time.Now().Add(time.Second))
+		s.sendRaw(id, conn, data)
 	}
 	return nil
 }
@@ -340,26 +730,214 @@ func (s *mmoServer) handleMoveRequest(id string, req *shared.MoveRequest) error
 		return errors.New("requesting player "+id+" is nil??", nil)
 	}
 
-	player.Position = player.Position.Add(req.Direction.Scaled(2))
+	if req.Direction == pixel.ZV {
+		return nil
+	}
+	s.interruptCast(id, player)
+	// moves into blocked tiles only turn the player
+	if next := player.Position.Add(req.Direction.Unit().Scaled(s.cfg().MoveSpeed)); !s.tileMap.BlockedAt(next) {
+		player.Position = next
+	}
+	if facing := shared.ScreenDirection(req.Direction); facing != shared.DIR_NONE {
+		player.Facing = facing
+	}
+	player.Action = shared.A_WALK
+	moved := player.Entity.Copy()
 	s.queueUpdate(func() error {
-		return s.broadcastPlayerMoved(id, player.Position, req.Created)
+		return s.broadcastEntityMoved(moved, req.Created)
 	})
 	return nil
 }
 
-func (s *mmoServer) handleSpeakRequest(id string, req *shared.SpeakRequest) error {
+func (s *mmoServer) handleShootRequest(id string, req *shared.ShootRequest) error {
 	s.playersLock.RLock()
 	player := s.players[id]
 	s.playersLock.RUnlock()
 	if player == nil {
 		return errors.New("requesting player "+id+" is nil??", nil)
 	}
-	s.queueUpdate(func() error {
-		return s.broadcastPlayerSpoke(id, req.Text)
+	if req.Direction == pixel.ZV {
+		return errors.New("player "+id+" shot without a direction", nil)
+	}
+	// shots faster than the cooldown come from a modified client, and are dropped. A tick
+	// of slack allows for requests arriving in the tick before they were due
+	if s.now.Sub(player.LastShot)+seconds(s.tickTime) < seconds(s.cfg().ShootCooldown) {
+		netLog.Debug("shot too soon", "player", id)
+		return nil
+	}
+	player.LastShot = s.now
+
+	direction := req.Direction.Unit()
+	s.spawnEntity(&shared.Entity{
+		Kind:     shared.E_PROJECTILE,
+		Position: player.Position,
+		Facing:   shared.ScreenDirection(direction),
+		Projectile: &shared.Projectile{
+			OwnerID:  id,
+			Velocity: direction.Scaled(s.cfg().ProjectileSpeed),
+			Range:    s.cfg().ProjectileRange,
+		},
 	})
 	return nil
 }
 
+// spawnEntity gives the entity a unique ID, adds it to the world and tells every player about it
+func (s *mmoServer) spawnEntity(entity *shared.Entity) {
+	s.entityCount++
+	entity.ID = fmt.Sprintf("%s-%v", entity.Kind, s.entityCount)
+	s.entities[entity.ID] = entity
+	spawned := entity.Copy()
+	s.queueUpdate(func() error {
+		return s.broadcastEntitySpawned(spawned)
+	})
+}
+
+// removeEntity takes the entity out of the world and tells every player
+func (s *mmoServer) removeEntity(id, hitID string) {
+	delete(s.entities, id)
+	s.queueUpdate(func() error {
+		return s.broadcastEntityRemoved(id, hitID)
+	})
+}
+
+// updateEntities advances the simulation of every non-player entity by one tick
+func (s *mmoServer) updateEntities() {
+	// sorted once for every NPC looking for players
+	players := s.playerIDs()
+	for _, id := range sortedKeys(s.entities) {
+		entity, ok := s.entities[id]
+		if !ok {
+			continue // removed earlier in the tick
+		}
+		switch entity.Kind {
+		case shared.E_PROJECTILE:
+			s.updateProjectile(entity)
+		case shared.E_NPC:
+			s.updateNPC(s.npcs[entity.ID], s.now, players)
+		}
+	}
+}
+
+// updateProjectile moves a projectile and removes it if it hit something or ran out of range
+func (s *mmoServer) updateProjectile(entity *shared.Entity) {
+	from := entity.Position
+	inRange := entity.Step(s.tickTime)
+	hitID := s.projectileHit(entity, from)
+	if inRange && hitID == "" && !s.tileMap.BlockedAt(entity.Position) {
+		return
+	}
+	s.removeEntity(entity.ID, hitID)
+	damage := s.cfg().ProjectileDamage
+	s.playersLock.RLock()
+	if owner, ok := s.players[entity.Projectile.OwnerID]; ok {
+		damage += owner.Strength
+	}
+	s.playersLock.RUnlock()
+	if n, ok := s.npcs[hitID]; ok {
+		s.damageNPC(n, damage, entity.Projectile.OwnerID)
+	} else if hitID != "" {
+		s.damagePlayer(hitID, damage)
+	}
+}
+
+// projectileHit returns the ID of the first player or NPC within reach of the path the projectile took this tick
+func (s *mmoServer) projectileHit(entity *shared.Entity, from pixel.Vec) string {
+	s.playersLock.RLock()
+	for _, id := range sortedKeys(s.players) {
+		player := s.players[id]
+		if id == entity.Projectile.OwnerID || !player.DeadUntil.IsZero() {
+			continue
+		}
+		if distanceToSegment(player.Position, from, entity.Position) <= s.cfg().ProjectileHitRadius {
+			s.playersLock.RUnlock()
+			return id
+		}
+	}
+	s.playersLock.RUnlock()
+	for _, id := range sortedKeys(s.npcs) {
+		n := s.npcs[id]
+		if !n.deadUntil.IsZero() {
+			continue
+		}
+		if distanceToSegment(n.entity.Position, from, entity.Position) <= s.cfg().ProjectileHitRadius {
+			return id
+		}
+	}
+	return ""
+}
+
+// damagePlayer lowers a player's health and lets them know
+func (s *mmoServer) damagePlayer(id string, amount float64) {
+	s.playersLock.RLock()
+	player := s.players[id]
+	s.playersLock.RUnlock()
+	if player == nil || !player.DeadUntil.IsZero() {
+		return
+	}
+	s.hurtPlayer(id, player, amount)
+	s.queueUpdate(func() error {
+		return s.sendPlayerResources(id)
+	})
+}
+
+// hurtPlayer lowers a living player's health, killing them if it runs out
+func (s *mmoServer) hurtPlayer(id string, player *shared.ServerPlayer, amount float64) {
+	player.Health = math.Max(0, player.Health-amount)
+	if player.Health > 0 {
+		return
+	}
+	s.interruptCast(id, player)
+	player.DeadUntil = s.now.Add(seconds(s.cfg().RespawnTime))
+	player.Action = shared.A_DEAD
+	died := player.Entity.Copy()
+	s.queueUpdate(func() error {
+		return s.broadcastEntityMoved(died, s.now)
+	})
+	s.queueNotice(id, fmt.Sprintf("you died, respawning in %v seconds", s.cfg().RespawnTime))
+}
+
+// isDead reports whether a player is waiting to respawn
+func (s *mmoServer) isDead(id string) bool {
+	s.playersLock.RLock()
+	defer s.playersLock.RUnlock()
+	player := s.players[id]
+	return player != nil && !player.DeadUntil.IsZero()
+}
+
+// respawnPlayers brings back dead players whose respawn time has passed, at the spawn point
+// with full health and mana
+func (s *mmoServer) respawnPlayers() {
+	s.playersLock.RLock()
+	defer s.playersLock.RUnlock()
+	for _, id := range sortedKeys(s.players) {
+		id, player := id, s.players[id]
+		if player.DeadUntil.IsZero() || s.now.Before(player.DeadUntil) {
+			continue
+		}
+		player.DeadUntil = time.Time{}
+		player.Health, player.Mana = player.MaxHealth, player.MaxMana
+		player.Position = playerSpawn
+		player.Facing, player.Action = shared.DOWN, shared.A_IDLE
+		respawned := player.Entity.Copy()
+		s.queueUpdate(func() error {
+			return s.broadcastEntityMoved(respawned, s.now)
+		})
+		s.queueUpdate(func() error {
+			return s.sendPlayerResources(id)
+		})
+	}
+}
+
+// distanceToSegment returns the shortest distance from p to the line segment a-b
+func distanceToSegment(p, a, b pixel.Vec) float64 {
+	ab := b.Sub(a)
+	if ab == pixel.ZV {
+		return p.Sub(a).Len()
+	}
+	t := math.Max(0, math.Min(1, p.Sub(a).Dot(ab)/ab.Dot(ab)))
+	return p.Sub(a.Add(ab.Scaled(t))).Len()
+}
+
 func (s *mmoServer) queueUpdate(update func() error) {
 	s.updatesLock.Lock()
 	defer s.updatesLock.Unlock()
@@ -0,0 +1,208 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/faiface/pixel"
+	"github.com/mmogo/mmo/shared"
+)
+
+// newTestServer returns a server with the default game data in a temporary directory,
+// a function putting a new player into its world, and a function removing it all
+func newTestServer(t *testing.T) (*mmoServer, func(id string) *shared.ServerPlayer, func()) {
+	dir, err := ioutil.TempDir("", "mmo-server")
+	if err != nil {
+		t.Fatal(err)
+	}
+	store, err := newPlayerStore(filepath.Join(dir, "data"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := loadConfig("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	bans := &banList{path: filepath.Join(dir, "bans.json")}
+	s := newMMOServer(cfg, "", store, shared.DefaultSpells, defaultNPCDefinitions, shared.DefaultProgression, nil,
+		nil, []string{"alice"}, nil, bans, 1)
+
+	conns := []*testConn{}
+	join := func(id string) *shared.ServerPlayer {
+		record, err := store.Load(id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		conn := newTestConn(fmt.Sprintf("10.0.0.%v:5000", len(conns)+1))
+		conns = append(conns, conn)
+		s.join(&join{id: id, conn: conn, record: record})
+		if err := s.runUpdates(); err != nil {
+			t.Fatal(err)
+		}
+		return s.players[id]
+	}
+	return s, join, func() {
+		for _, conn := range conns {
+			conn.Close()
+		}
+		store.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+func TestPlayersNamedInAnyCase(t *testing.T) {
+	s, join, done := newTestServer(t)
+	defer done()
+	join("Bob")
+	if id, ok := s.onlineID("BOB"); !ok || id != "Bob" {
+		t.Errorf("BOB is online as %q %v, want Bob", id, ok)
+	}
+	if kicked := s.kickMatching(&ban{Target: "bob"}); kicked != 1 {
+		t.Errorf("ban of bob kicked %v players, want Bob", kicked)
+	}
+	s.mute("Bob", s.now.Add(time.Minute))
+	if until := s.mutedUntil("BOB", s.now); until.IsZero() {
+		t.Error("BOB is not muted after muting Bob")
+	}
+}
+
+func TestPlayersSavedWhileOnline(t *testing.T) {
+	tests := []struct {
+		name  string
+		after time.Duration
+		save  func(s *mmoServer)
+		saved bool
+	}{
+		{name: "before the interval", after: time.Second},
+		{name: "after the interval", after: time.Minute, saved: true},
+		{name: "saved by an admin", after: time.Second, save: func(s *mmoServer) { s.runAdminCommand("console", "save") }, saved: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s, join, done := newTestServer(t)
+			defer done()
+			alice := join("alice")
+			s.lastSave = s.now
+			alice.Position = pixel.V(100, 0)
+			s.now = s.now.Add(test.after)
+			if test.save != nil {
+				test.save(s)
+			}
+			if err := s.finishTick(); err != nil {
+				t.Fatal(err)
+			}
+			record, err := s.store.Load("alice")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if saved := record.Position == alice.Position; saved != test.saved {
+				t.Errorf("saved at %v, want saved %v", record.Position, test.saved)
+			}
+		})
+	}
+}
+
+// deadConn is a connection which can no longer be written to
+type deadConn struct {
+	*testConn
+}
+
+func (c deadConn) Write(b []byte) (int, error) {
+	return 0, errors.New("connection reset")
+}
+
+func TestDeadConnection(t *testing.T) {
+	s, join, done := newTestServer(t)
+	defer done()
+	alice, bob, carol := join("alice"), join("bob"), join("carol")
+	dead := deadConn{newTestConn("10.0.0.9:5000")}
+	bob.Conn = dead
+	aliceConn, carolConn := alice.Conn.(*testConn), carol.Conn.(*testConn)
+	before := atomic.LoadInt64(&carolConn.writes)
+
+	s.queueUpdate(func() error {
+		return s.broadcast(&shared.Message{Update: &shared.Update{Notice: &shared.Notice{Text: "hello"}}})
+	})
+	s.queueUpdate(func() error {
+		return s.send("bob", &shared.Message{Update: &shared.Update{Notice: &shared.Notice{Text: "hello bob"}}})
+	})
+	s.queueUpdate(func() error {
+		return s.send("alice", &shared.Message{Update: &shared.Update{Notice: &shared.Notice{Text: "hello alice"}}})
+	})
+	if err := s.runUpdates(); err != nil {
+		t.Errorf("updates failed: %v", err)
+	}
+	if got := atomic.LoadInt64(&carolConn.writes) - before; got != 1 {
+		t.Errorf("carol was sent %v messages, want the broadcast after bob's", got)
+	}
+	if got := atomic.LoadInt64(&aliceConn.writes); got == 0 {
+		t.Error("alice was sent nothing")
+	}
+	if len(s.updates) != 0 {
+		t.Errorf("%v updates left queued", len(s.updates))
+	}
+	select {
+	case <-dead.closed:
+	default:
+		t.Error("bob's dead connection was left open")
+	}
+}
+
+func TestWorldStateParts(t *testing.T) {
+	tests := []struct {
+		name     string
+		entities int
+		parts    int // at least
+	}{
+		{name: "empty world", entities: 0, parts: 1},
+		{name: "a few players", entities: 10, parts: 1},
+		{name: "thousands of players", entities: 5000, parts: 2},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			entities := []*shared.Entity{}
+			for i := 0; i < test.entities; i++ {
+				entities = append(entities, &shared.Entity{
+					ID:         fmt.Sprintf("player%v", i),
+					Kind:       shared.E_PLAYER,
+					Position:   pixel.V(float64(i), float64(-i)),
+					Equipment:  &shared.Equipment{},
+					Appearance: &shared.Appearance{Color: shared.CharacterColors[0]},
+				})
+			}
+			parts, err := worldStateParts(entities)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(parts) < test.parts {
+				t.Errorf("%v parts, want at least %v", len(parts), test.parts)
+			}
+			sent := 0
+			for i, part := range parts {
+				msg := &shared.Message{Update: &shared.Update{WorldState: &shared.WorldState{Entities: part, Part: i, Parts: len(parts)}}}
+				data, err := shared.Encode(msg)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if err := shared.SendRaw(data, ioutil.Discard); err != nil {
+					t.Errorf("part %v can't be sent: %v", i, err)
+				}
+				for _, entity := range part {
+					if entity != entities[sent] {
+						t.Fatalf("part %v has %s where %s should be", i, entity.ID, entities[sent].ID)
+					}
+					sent++
+				}
+			}
+			if sent != len(entities) {
+				t.Errorf("%v entities sent, want %v", sent, len(entities))
+			}
+		})
+	}
+}
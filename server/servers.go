@@ -0,0 +1,58 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/mmogo/mmo/shared"
+)
+
+// loadServerList reads the servers the patcher offers players, a JSON list of names, addresses
+// and the https URLs of their account endpoints, e.g.
+//
+//	[{"Name": "Europe", "Addr": "eu.example.com:8080", "Accounts": "https://eu.example.com:8443"},
+//	 {"Name": "America", "Addr": "us.example.com:8080", "Accounts": "https://us.example.com:8443"}]
+func loadServerList(path string) ([]shared.ServerInfo, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var servers []shared.ServerInfo
+	if err := json.Unmarshal(data, &servers); err != nil {
+		return nil, fmt.Errorf("parsing %s: %v", path, err)
+	}
+	for _, server := range servers {
+		if server.Name == "" || server.Addr == "" {
+			return nil, fmt.Errorf("%s: servers need a Name and an Addr", path)
+		}
+		if server.Accounts != "" && !strings.HasPrefix(server.Accounts, "https://") && !strings.HasPrefix(server.Accounts, "http://") {
+			return nil, fmt.Errorf("%s: the Accounts of %s must be an http or https URL", path, server.Name)
+		}
+	}
+	return servers, nil
+}
+
+// serveServers lists the servers to the patcher. Without a list, it is just this server
+// at the address it was asked on, with its account endpoints on the same host
+func (s *mmoServer) serveServers(w http.ResponseWriter, req *http.Request) {
+	servers := s.servers
+	if len(servers) == 0 {
+		servers = []shared.ServerInfo{{Name: req.Host, Addr: req.Host}}
+		if s.accountsTLS != "" {
+			host, _, err := net.SplitHostPort(req.Host)
+			if err != nil {
+				host = req.Host
+			}
+			_, port, _ := net.SplitHostPort(s.accountsTLS)
+			servers[0].Accounts = "https://" + net.JoinHostPort(host, port)
+		}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(servers); err != nil {
+		netLog.Warn("sending server list", "to", req.RemoteAddr, "err", err)
+	}
+}
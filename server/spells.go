@@ -0,0 +1,242 @@
+package main
+
+import (
+	"math"
+	"time"
+
+	"github.com/faiface/pixel"
+	"github.com/ilackarms/pkg/errors"
+	"github.com/mmogo/mmo/shared"
+)
+
+func seconds(s float64) time.Duration {
+	return time.Duration(s * float64(time.Second))
+}
+
+// finite reports whether both coordinates of v are numbers. Distances to anything else
+// compare false both ways, so checks on them are written to fail when they do
+func finite(v pixel.Vec) bool {
+	return !math.IsNaN(v.X) && !math.IsInf(v.X, 0) && !math.IsNaN(v.Y) && !math.IsInf(v.Y, 0)
+}
+
+func (s *mmoServer) handleCastRequest(id string, req *shared.CastRequest) error {
+	s.playersLock.RLock()
+	player := s.players[id]
+	s.playersLock.RUnlock()
+	if player == nil {
+		return errors.New("requesting player "+id+" is nil??", nil)
+	}
+
+	spell, ok := s.spells[req.Spell]
+	reason := ""
+	switch {
+	case !ok:
+		reason = "unknown spell"
+	case player.Casting != nil:
+		reason = "already casting"
+	case s.now.Before(player.Cooldowns[spell.Name]):
+		reason = "not ready yet"
+	case player.Mana < spell.ManaCost:
+		reason = "not enough mana"
+	case !finite(req.Target):
+		reason = "bad target"
+	case !(req.Target.Sub(player.Position).Len() <= spell.Range):
+		reason = "out of range"
+	}
+	if reason != "" {
+		s.queueUpdate(func() error {
+			return s.sendCastFailed(id, req.Spell, reason)
+		})
+		return nil
+	}
+
+	player.Casting = &shared.Cast{
+		Spell:     spell,
+		Target:    req.Target,
+		Completes: s.now.Add(seconds(spell.CastTime)),
+	}
+	s.queueUpdate(func() error {
+		return s.broadcastCastStarted(id, spell, req.Target)
+	})
+	return nil
+}
+
+// interruptCast cancels a cast in progress. Nothing is spent on an interrupted cast
+func (s *mmoServer) interruptCast(id string, player *shared.ServerPlayer) {
+	if player.Casting == nil {
+		return
+	}
+	name := player.Casting.Spell.Name
+	player.Casting = nil
+	s.queueUpdate(func() error {
+		return s.sendCastFailed(id, name, "interrupted")
+	})
+}
+
+// updateCasts regenerates mana and resolves every cast which has finished channelling
+func (s *mmoServer) updateCasts() {
+	now := s.now
+	type finishedCast struct {
+		id     string
+		caster *shared.ServerPlayer
+		cast   *shared.Cast
+	}
+	finished := []finishedCast{}
+	s.playersLock.RLock()
+	for _, id := range sortedKeys(s.players) {
+		id, player := id, s.players[id]
+		if player.Mana < player.MaxMana {
+			before := player.Mana
+			player.Mana = math.Min(player.MaxMana, player.Mana+s.cfg().ManaRegen*s.tickTime)
+			if math.Floor(before) != math.Floor(player.Mana) {
+				s.queueUpdate(func() error {
+					return s.sendPlayerResources(id)
+				})
+			}
+		}
+		if player.Casting == nil || now.Before(player.Casting.Completes) {
+			continue
+		}
+		finished = append(finished, finishedCast{id: id, caster: player, cast: player.Casting})
+		player.Casting = nil
+	}
+	s.playersLock.RUnlock()
+
+	for _, f := range finished {
+		s.resolveCast(f.id, f.caster, f.cast, now)
+	}
+}
+
+// resolveCast applies a completed spell to every player and NPC in its area. Casters
+// are never hurt by their own spells
+func (s *mmoServer) resolveCast(id string, caster *shared.ServerPlayer, cast *shared.Cast, now time.Time) {
+	spell := cast.Spell
+	if caster.Mana < spell.ManaCost {
+		s.queueUpdate(func() error {
+			return s.sendCastFailed(id, spell.Name, "not enough mana")
+		})
+		return
+	}
+	caster.Mana -= spell.ManaCost
+	caster.Cooldowns[spell.Name] = now.Add(seconds(spell.Cooldown))
+
+	affected := []string{}
+	s.playersLock.RLock()
+	for _, targetID := range sortedKeys(s.players) {
+		target := s.players[targetID]
+		if !(target.Position.Sub(cast.Target).Len() <= spell.Radius) {
+			continue
+		}
+		if !target.DeadUntil.IsZero() || spell.Effect == shared.EffectDamage && targetID == id {
+			continue
+		}
+		switch spell.Effect {
+		case shared.EffectDamage:
+			s.hurtPlayer(targetID, target, spell.Amount)
+		case shared.EffectHeal:
+			target.Health = math.Min(target.MaxHealth, target.Health+spell.Amount)
+		}
+		affected = append(affected, targetID)
+	}
+	s.playersLock.RUnlock()
+
+	players := len(affected)
+	if spell.Effect == shared.EffectDamage {
+		for _, targetID := range sortedKeys(s.npcs) {
+			n := s.npcs[targetID]
+			if n.deadUntil.IsZero() && n.entity.Position.Sub(cast.Target).Len() <= spell.Radius {
+				s.damageNPC(n, spell.Amount, id)
+				affected = append(affected, targetID)
+			}
+		}
+	}
+
+	s.queueUpdate(func() error {
+		return s.broadcastSpellResolved(id, spell, cast.Target, affected)
+	})
+	s.queueUpdate(func() error {
+		return s.sendPlayerResources(id)
+	})
+	for _, targetID := range affected[:players] {
+		if targetID == id {
+			continue
+		}
+		targetID := targetID
+		s.queueUpdate(func() error {
+			return s.sendPlayerResources(targetID)
+		})
+	}
+}
+
+func (s *mmoServer) sendSpellBook(id string) error {
+	return s.send(id, &shared.Message{
+		Update: &shared.Update{SpellBook: &shared.SpellBook{Spells: s.spellList}},
+	})
+}
+
+func (s *mmoServer) sendCastFailed(id, spell, reason string) error {
+	return s.send(id, &shared.Message{
+		Update: &shared.Update{CastFailed: &shared.CastFailed{
+			Spell:  spell,
+			Reason: reason,
+		}},
+	})
+}
+
+func (s *mmoServer) sendPlayerResources(id string) error {
+	s.playersLock.RLock()
+	player, ok := s.players[id]
+	s.playersLock.RUnlock()
+	if !ok {
+		return nil
+	}
+	now := s.now
+	cooldowns := make(map[string]float64)
+	for name, ready := range player.Cooldowns {
+		if remaining := ready.Sub(now).Seconds(); remaining > 0 {
+			cooldowns[name] = remaining
+		}
+	}
+	nextLevelXP := 0
+	if player.Stats.Level < s.progression.MaxLevel {
+		nextLevelXP = s.progression.XPForLevel(player.Stats.Level + 1)
+	}
+	return s.send(id, &shared.Message{
+		Update: &shared.Update{PlayerResources: &shared.PlayerResources{
+			Health:      player.Health,
+			MaxHealth:   player.MaxHealth,
+			Mana:        player.Mana,
+			MaxMana:     player.MaxMana,
+			Cooldowns:   cooldowns,
+			Level:       player.Stats.Level,
+			XP:          player.XP,
+			NextLevelXP: nextLevelXP,
+			Strength:    player.Strength,
+		}},
+	})
+}
+
+func (s *mmoServer) broadcastCastStarted(id string, spell *shared.Spell, target pixel.Vec) error {
+	castStarted := &shared.Message{
+		Update: &shared.Update{CastStarted: &shared.CastStarted{
+			ID:       id,
+			Spell:    spell.Name,
+			Target:   target,
+			CastTime: spell.CastTime,
+		}},
+	}
+	return s.broadcast(castStarted)
+}
+
+func (s *mmoServer) broadcastSpellResolved(id string, spell *shared.Spell, target pixel.Vec, affected []string) error {
+	spellResolved := &shared.Message{
+		Update: &shared.Update{SpellResolved: &shared.SpellResolved{
+			ID:       id,
+			Spell:    spell.Name,
+			Target:   target,
+			Radius:   spell.Radius,
+			Affected: affected,
+		}},
+	}
+	return s.broadcast(spellResolved)
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"math"
+	"testing"
+
+	"github.com/faiface/pixel"
+	"github.com/mmogo/mmo/shared"
+)
+
+func TestCastAtBadTarget(t *testing.T) {
+	tests := []struct {
+		name   string
+		target pixel.Vec
+	}{
+		{name: "not a number", target: pixel.V(math.NaN(), 0)},
+		{name: "infinite", target: pixel.V(0, math.Inf(1))},
+		{name: "negative infinite", target: pixel.V(math.Inf(-1), math.Inf(-1))},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s, join, done := newTestServer(t)
+			defer done()
+			alice, bob := join("alice"), join("bob")
+			// far enough that only a broken distance check could reach him
+			bob.Position = pixel.V(5000, 5000)
+
+			s.handleCastRequest("alice", &shared.CastRequest{Spell: "frost nova", Target: test.target})
+			if alice.Casting != nil {
+				t.Fatalf("started casting at %v", test.target)
+			}
+
+			// a cast already underway with a bad target hits nobody
+			health := bob.Health
+			s.resolveCast("alice", alice, &shared.Cast{Spell: s.spells["frost nova"], Target: test.target}, s.now)
+			if bob.Health != health {
+				t.Errorf("bob was hurt from %v to %v", health, bob.Health)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/mmogo/mmo/shared"
+)
+
+// awardXP gives a player experience for a kill or quest and levels them up when they earn enough.
+// The reason is shown to the player, e.g. "killed goblin"
+func (s *mmoServer) awardXP(id string, amount int, reason string) {
+	if amount <= 0 {
+		return
+	}
+	s.playersLock.RLock()
+	player, ok := s.players[id]
+	s.playersLock.RUnlock()
+	if !ok {
+		return
+	}
+	before := player.Stats.Level
+	player.XP += amount
+	s.progression.Apply(player.Stats)
+	s.queueNotice(id, fmt.Sprintf("+%v xp (%s)", amount, reason))
+	if player.Stats.Level > before {
+		// a new level comes with full health and mana
+		player.Health = player.MaxHealth
+		player.Mana = player.MaxMana
+		player.Entity.Level = player.Stats.Level
+		level := player.Stats.Level
+		s.queueNotice(id, fmt.Sprintf("Level %v!", level))
+		s.queueUpdate(func() error {
+			return s.broadcastPlayerLevelled(id, level)
+		})
+	}
+	s.queueUpdate(func() error {
+		return s.sendPlayerResources(id)
+	})
+}
+
+func (s *mmoServer) broadcastPlayerLevelled(id string, level int) error {
+	levelled := &shared.Message{
+		Update: &shared.Update{PlayerLevelled: &shared.PlayerLevelled{
+			ID:    id,
+			Level: level,
+		}},
+	}
+	return s.broadcast(levelled)
+}
@@ -0,0 +1,202 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/faiface/pixel"
+	"github.com/mmogo/mmo/shared"
+)
+
+// playerSpawn is where new players start and dead ones respawn
+var playerSpawn = pixel.ZV
+
+// playerRecord is the part of a player kept between sessions
+type playerRecord struct {
+	ID         string
+	Position   pixel.Vec
+	Inventory  *shared.Inventory
+	Equipment  *shared.Equipment
+	Stats      *shared.Stats      // nil until first saved
+	Appearance *shared.Appearance // nil for players from before characters were created
+}
+
+// playerStore keeps a JSON file per player in a directory. Saves are written behind the
+// game loop, one at a time, and reading a player waits for their saves to be written.
+// Like account names, player IDs are case insensitive. A record keeps the ID it was
+// first saved with, which is how the player is known in the game
+type playerStore struct {
+	dir string
+
+	lock    sync.Mutex
+	changed *sync.Cond        // broadcast when a save is queued or written, or the store closes
+	pending map[string][]byte // records waiting to be written, by lower case ID
+	writing string            // lower case ID of the record being written
+	closed  bool
+}
+
+func newPlayerStore(dir string) (*playerStore, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	st := &playerStore{dir: dir, pending: make(map[string][]byte)}
+	if err := st.lowerFileNames(); err != nil {
+		return nil, err
+	}
+	st.changed = sync.NewCond(&st.lock)
+	go st.writeBehind()
+	return st, nil
+}
+
+func (st *playerStore) path(id string) string {
+	return filepath.Join(st.dir, url.PathEscape(strings.ToLower(id))+".json")
+}
+
+// lowerFileNames renames the records saved before IDs were case insensitive. Two players
+// whose IDs differ only in case can't both be kept, so the store refuses to open until
+// one of them is removed by hand
+func (st *playerStore) lowerFileNames() error {
+	files, err := ioutil.ReadDir(st.dir)
+	if err != nil {
+		return err
+	}
+	for _, f := range files {
+		name := f.Name()
+		if filepath.Ext(name) != ".json" {
+			continue
+		}
+		id, err := url.PathUnescape(strings.TrimSuffix(name, ".json"))
+		if err != nil || id == strings.ToLower(id) {
+			continue
+		}
+		to := st.path(id)
+		if _, err := os.Stat(to); err == nil {
+			return fmt.Errorf("players %s and %s only differ in case, remove one of them", filepath.Join(st.dir, name), to)
+		}
+		if err := os.Rename(filepath.Join(st.dir, name), to); err != nil {
+			return err
+		}
+		serverLog.Info("renamed player record", "player", id, "to", to)
+	}
+	return nil
+}
+
+// Exists reports whether a player has a saved record
+func (st *playerStore) Exists(id string) (bool, error) {
+	st.wait(id)
+	_, err := os.Stat(st.path(id))
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// Load returns the saved record of a player, or a fresh one if they have never played
+func (st *playerStore) Load(id string) (*playerRecord, error) {
+	st.wait(id)
+	data, err := ioutil.ReadFile(st.path(id))
+	if os.IsNotExist(err) {
+		return &playerRecord{
+			ID:        id,
+			Position:  playerSpawn,
+			Inventory: &shared.Inventory{},
+			Equipment: &shared.Equipment{},
+		}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var record playerRecord
+	if err := json.Unmarshal(data, &record); err != nil {
+		return nil, err
+	}
+	if record.Inventory == nil {
+		record.Inventory = &shared.Inventory{}
+	}
+	if record.Equipment == nil {
+		record.Equipment = &shared.Equipment{}
+	}
+	return &record, nil
+}
+
+// Save queues the record of a player to replace the previous one. The record is copied
+// straight away, so the player can go on changing
+func (st *playerStore) Save(record *playerRecord) error {
+	data, err := json.MarshalIndent(record, "", "  ")
+	if err != nil {
+		return err
+	}
+	st.lock.Lock()
+	defer st.lock.Unlock()
+	if st.closed {
+		return fmt.Errorf("saving %s: player store closed", record.ID)
+	}
+	st.pending[strings.ToLower(record.ID)] = data
+	st.changed.Broadcast()
+	return nil
+}
+
+// Close writes the saves still queued and stops saving
+func (st *playerStore) Close() {
+	st.lock.Lock()
+	defer st.lock.Unlock()
+	st.closed = true
+	st.changed.Broadcast()
+	for len(st.pending) > 0 || st.writing != "" {
+		st.changed.Wait()
+	}
+}
+
+// wait returns once no save of a player is queued or being written
+func (st *playerStore) wait(id string) {
+	id = strings.ToLower(id)
+	st.lock.Lock()
+	defer st.lock.Unlock()
+	for st.pending[id] != nil || st.writing == id {
+		st.changed.Wait()
+	}
+}
+
+// writeBehind writes queued saves until the store is closed
+func (st *playerStore) writeBehind() {
+	st.lock.Lock()
+	defer st.lock.Unlock()
+	for {
+		for len(st.pending) == 0 && !st.closed {
+			st.changed.Wait()
+		}
+		if len(st.pending) == 0 {
+			return
+		}
+		var id string
+		for id = range st.pending {
+			break
+		}
+		data := st.pending[id]
+		delete(st.pending, id)
+		st.writing = id
+		st.lock.Unlock()
+		err := st.write(id, data)
+		st.lock.Lock()
+		st.writing = ""
+		st.changed.Broadcast()
+		if err != nil {
+			serverLog.Error("saving player failed", "player", id, "err", err)
+		}
+	}
+}
+
+func (st *playerStore) write(id string, data []byte) error {
+	// write then rename so a crash never leaves a half written record
+	tmp := st.path(id) + ".tmp"
+	if err := ioutil.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, st.path(id))
+}
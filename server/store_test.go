@@ -0,0 +1,55 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestPlayerStoreLowersFileNames(t *testing.T) {
+	tests := []struct {
+		name  string
+		files []string // saved before IDs were case insensitive
+		ok    bool
+	}{
+		{name: "one player", files: []string{"Carol.json"}, ok: true},
+		{name: "already lower case", files: []string{"carol.json"}, ok: true},
+		{name: "players only differing in case", files: []string{"Carol.json", "carol.json"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dir, err := ioutil.TempDir("", "mmo-store")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(dir)
+			for _, f := range test.files {
+				if err := ioutil.WriteFile(filepath.Join(dir, f), []byte(`{"ID": "Carol"}`), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			store, err := newPlayerStore(dir)
+			if !test.ok {
+				if err == nil {
+					store.Close()
+					t.Fatal("opened a store with players only differing in case")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer store.Close()
+			for _, id := range []string{"Carol", "carol", "CAROL"} {
+				record, err := store.Load(id)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if record.ID != "Carol" {
+					t.Errorf("%s loaded as %q, want the saved Carol", id, record.ID)
+				}
+			}
+		})
+	}
+}
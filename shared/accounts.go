@@ -0,0 +1,103 @@
+package shared
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Accounts are kept by the game server and reached over HTTP before connecting. Logging in
+// returns a token, sent in the ConnectRequest to play one of the account's characters.
+// Requests and responses are JSON, and failures are a status code with the reason as plain text.
+//
+// Passwords and tokens are sent in these requests, so they must go over HTTPS: either the
+// server serves the account endpoints over TLS on an address of their own, or the endpoints
+// on the game port are put behind a TLS proxy. Either way the ServerInfo of the server gives
+// the https URL to reach them at
+
+const (
+	RegisterPath        = "/account/register"  // AccountRequest in, nothing out
+	LoginPath           = "/account/login"     // AccountRequest in, LoginResponse out
+	CreateCharacterPath = "/account/character" // CreateCharacterRequest in, LoginResponse out
+	ServersPath         = "/servers"           // a list of ServerInfo out
+)
+
+// MinPasswordLength is the shortest password an account can have
+const MinPasswordLength = 6
+
+// namePattern is what account and character names must look like
+var namePattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_-]{2,15}$`)
+
+// ValidName returns an error saying what is wrong with an account or character name, or nil
+func ValidName(name string) error {
+	if !namePattern.MatchString(name) {
+		return fmt.Errorf("names are 3 to 16 letters, digits, - or _, starting with a letter")
+	}
+	return nil
+}
+
+// Appearance is how a character looks, chosen when it is created
+type Appearance struct {
+	Color string // tint, from golang.org/x/image/colornames
+}
+
+// CharacterColors are the tints a new character can choose from
+var CharacterColors = []string{
+	"white", "lightcoral", "sandybrown", "khaki", "palegreen",
+	"mediumaquamarine", "lightskyblue", "plum", "hotpink", "tan",
+}
+
+// ValidAppearance returns an error if a new character can't look like this, or nil
+func ValidAppearance(a *Appearance) error {
+	if a == nil {
+		return fmt.Errorf("no appearance chosen")
+	}
+	for _, c := range CharacterColors {
+		if a.Color == c {
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown character color %q", a.Color)
+}
+
+// ServerInfo is a game server players can choose from
+type ServerInfo struct {
+	Name     string
+	Addr     string // host:port of the game
+	Accounts string `json:",omitempty"` // base URL of the account endpoints, e.g. https://host:8443
+}
+
+// AccountsURL returns the base URL of the server's account endpoints. Without one
+// they are on the game port over plain HTTP, which is only fit for testing
+func (s ServerInfo) AccountsURL() string {
+	if s.Accounts == "" {
+		return "http://" + s.Addr
+	}
+	return strings.TrimSuffix(s.Accounts, "/")
+}
+
+// AccountRequest names an account and its password, to create it or log in
+type AccountRequest struct {
+	Account  string
+	Password string
+}
+
+// CreateCharacterRequest adds a character to the account a token was given for
+type CreateCharacterRequest struct {
+	Token      string
+	Name       string
+	Appearance *Appearance
+}
+
+// LoginResponse is a token to connect with and the characters it can play
+type LoginResponse struct {
+	Token      string
+	Characters []*Character
+}
+
+// Character is a character of an account, as listed on the character screen
+type Character struct {
+	ID         string
+	Level      int
+	Appearance *Appearance
+}
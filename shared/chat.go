@@ -0,0 +1,12 @@
+package shared
+
+// ChatChannel decides who hears a chat message
+type ChatChannel string
+
+const (
+	CHAT_SAY     ChatChannel = "say"     // players nearby
+	CHAT_SHOUT   ChatChannel = "shout"   // everyone
+	CHAT_WHISPER ChatChannel = "whisper" // one player
+	CHAT_PARTY   ChatChannel = "party"   // the speaker's party
+	CHAT_SYSTEM  ChatChannel = "system"  // from the server to one player
+)
@@ -49,11 +49,26 @@ func GetMessage(r io.Reader, withDeadline ...bool) (*Message, error) {
 	}
 	var msg Message
 	if err := bson.Unmarshal(raw, &msg); err != nil {
-		return nil, err
+		return nil, &decodeError{err: err}
 	}
 	return &msg, nil
 }
 
+// decodeError is returned by GetMessage for a message which arrived but could not be decoded
+type decodeError struct {
+	err error
+}
+
+func (e *decodeError) Error() string {
+	return fmt.Sprintf("decoding message: %v", e.err)
+}
+
+// IsDecodeError reports whether GetMessage failed on a malformed message rather than the connection
+func IsDecodeError(err error) bool {
+	_, ok := err.(*decodeError)
+	return ok
+}
+
 func SendMessage(msg *Message, w io.Writer, withDeadline ...bool) error {
 	if len(withDeadline) > 0 && withDeadline[0] {
 		if conn, ok := w.(net.Conn); ok {
@@ -0,0 +1,83 @@
+package shared
+
+import "github.com/faiface/pixel"
+
+// EntityKind describes what an entity represents
+type EntityKind int
+
+const (
+	E_PLAYER EntityKind = iota
+	E_NPC
+	E_ITEM
+	E_PROJECTILE
+)
+
+func (k EntityKind) String() string {
+	switch k {
+	case E_PLAYER:
+		return "player"
+	case E_NPC:
+		return "npc"
+	case E_ITEM:
+		return "item"
+	case E_PROJECTILE:
+		return "projectile"
+	default:
+		return "unknown"
+	}
+}
+
+// Entity is any object in the world. Data specific to a kind
+// of entity lives in its components, which are nil when unused
+type Entity struct {
+	ID       string
+	Kind     EntityKind
+	Position pixel.Vec
+	Facing   Direction
+	Action   Action
+	Level    int // character level, zero for entities without one
+
+	Projectile *Projectile `,omitempty`
+	Item       *Item       `,omitempty`
+	NPC        *NPC        `,omitempty`
+	Equipment  *Equipment  `,omitempty`
+	Appearance *Appearance `,omitempty`
+}
+
+// Copy returns a copy of the entity safe to hand to another goroutine
+func (e *Entity) Copy() *Entity {
+	c := *e
+	if e.Projectile != nil {
+		p := *e.Projectile
+		c.Projectile = &p
+	}
+	if e.Item != nil {
+		i := *e.Item
+		c.Item = &i
+	}
+	if e.NPC != nil {
+		n := *e.NPC
+		c.NPC = &n
+	}
+	if e.Equipment != nil {
+		eq := *e.Equipment
+		c.Equipment = &eq
+	}
+	if e.Appearance != nil {
+		a := *e.Appearance
+		c.Appearance = &a
+	}
+	return &c
+}
+
+// NPC is the component of a computer controlled character
+type NPC struct {
+	Name  string
+	Color string // tint, from golang.org/x/image/colornames
+}
+
+// Item is the component of a stack of items lying on the ground
+type Item struct {
+	ItemID string
+	Count  int
+}
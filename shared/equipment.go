@@ -0,0 +1,52 @@
+package shared
+
+// EquipSlot is a place on a character an item can be worn
+type EquipSlot string
+
+const (
+	SLOT_HEAD   EquipSlot = "head"
+	SLOT_TORSO  EquipSlot = "torso"
+	SLOT_LEGS   EquipSlot = "legs"
+	SLOT_WEAPON EquipSlot = "weapon"
+)
+
+// EquipSlots lists every slot in the order their sheets are layered over the body
+var EquipSlots = []EquipSlot{SLOT_LEGS, SLOT_TORSO, SLOT_HEAD, SLOT_WEAPON}
+
+// Equipment is the component of the items a character is wearing,
+// by item ID. Empty slots are empty strings
+type Equipment struct {
+	Head   string
+	Torso  string
+	Legs   string
+	Weapon string
+}
+
+// Get returns the item ID in a slot
+func (e *Equipment) Get(slot EquipSlot) string {
+	switch slot {
+	case SLOT_HEAD:
+		return e.Head
+	case SLOT_TORSO:
+		return e.Torso
+	case SLOT_LEGS:
+		return e.Legs
+	case SLOT_WEAPON:
+		return e.Weapon
+	}
+	return ""
+}
+
+// Set puts an item ID into a slot
+func (e *Equipment) Set(slot EquipSlot, itemID string) {
+	switch slot {
+	case SLOT_HEAD:
+		e.Head = itemID
+	case SLOT_TORSO:
+		e.Torso = itemID
+	case SLOT_LEGS:
+		e.Legs = itemID
+	case SLOT_WEAPON:
+		e.Weapon = itemID
+	}
+}
@@ -0,0 +1,120 @@
+package shared
+
+import "fmt"
+
+// ItemDef describes a kind of item
+type ItemDef struct {
+	ID       string
+	Name     string
+	Sprite   string // asset drawn for the item on the ground
+	MaxStack int
+	Slot     EquipSlot // empty if the item cannot be equipped
+	Sheet    string    // LPC layer sheet drawn over the character when equipped
+}
+
+// Items is the table of every item in the game, by ID
+var Items = map[string]*ItemDef{
+	"gold":          {ID: "gold", Name: "Gold", Sprite: "sprites/loot.png", MaxStack: 1000},
+	"health_potion": {ID: "health_potion", Name: "Health Potion", Sprite: "sprites/loot.png", MaxStack: 10},
+	"wolf_pelt":     {ID: "wolf_pelt", Name: "Wolf Pelt", Sprite: "sprites/loot.png", MaxStack: 20},
+	"goblin_ear":    {ID: "goblin_ear", Name: "Goblin Ear", Sprite: "sprites/loot.png", MaxStack: 20},
+	"leather_cap": {ID: "leather_cap", Name: "Leather Cap", Sprite: "sprites/loot.png", MaxStack: 1,
+		Slot: SLOT_HEAD, Sheet: "sprites/equipment/leather_cap.png"},
+	"leather_armor": {ID: "leather_armor", Name: "Leather Armor", Sprite: "sprites/loot.png", MaxStack: 1,
+		Slot: SLOT_TORSO, Sheet: "sprites/equipment/leather_armor.png"},
+	"leather_pants": {ID: "leather_pants", Name: "Leather Pants", Sprite: "sprites/loot.png", MaxStack: 1,
+		Slot: SLOT_LEGS, Sheet: "sprites/equipment/leather_pants.png"},
+	"dagger": {ID: "dagger", Name: "Dagger", Sprite: "sprites/loot.png", MaxStack: 1,
+		Slot: SLOT_WEAPON, Sheet: "sprites/equipment/dagger.png"},
+}
+
+// PickupRadius is how close a player must be to pick up an item
+const PickupRadius = 64.0
+
+// InventorySize is the number of slots in a player's inventory
+const InventorySize = 20
+
+// InventorySlot is a stack of a single item
+type InventorySlot struct {
+	ItemID string
+	Count  int
+}
+
+// Inventory holds the items carried by a player
+type Inventory struct {
+	Slots []*InventorySlot
+}
+
+// Add puts count of an item into the inventory, filling existing stacks first.
+// It returns how many did not fit
+func (inv *Inventory) Add(itemID string, count int) (int, error) {
+	def, ok := Items[itemID]
+	if !ok {
+		return count, fmt.Errorf("unknown item %q", itemID)
+	}
+	for _, slot := range inv.Slots {
+		if count == 0 {
+			return 0, nil
+		}
+		if slot.ItemID != itemID || slot.Count >= def.MaxStack {
+			continue
+		}
+		n := minInt(count, def.MaxStack-slot.Count)
+		slot.Count += n
+		count -= n
+	}
+	for count > 0 && len(inv.Slots) < InventorySize {
+		n := minInt(count, def.MaxStack)
+		inv.Slots = append(inv.Slots, &InventorySlot{ItemID: itemID, Count: n})
+		count -= n
+	}
+	return count, nil
+}
+
+// Remove takes count of an item out of the inventory, reporting false if there are not enough
+func (inv *Inventory) Remove(itemID string, count int) bool {
+	if inv.Count(itemID) < count {
+		return false
+	}
+	slots := inv.Slots[:0]
+	for _, slot := range inv.Slots {
+		if slot.ItemID == itemID && count > 0 {
+			n := minInt(count, slot.Count)
+			slot.Count -= n
+			count -= n
+		}
+		if slot.Count > 0 {
+			slots = append(slots, slot)
+		}
+	}
+	inv.Slots = slots
+	return true
+}
+
+// Count returns how many of an item the inventory holds
+func (inv *Inventory) Count(itemID string) int {
+	total := 0
+	for _, slot := range inv.Slots {
+		if slot.ItemID == itemID {
+			total += slot.Count
+		}
+	}
+	return total
+}
+
+// Copy returns a deep copy of the inventory
+func (inv *Inventory) Copy() *Inventory {
+	c := &Inventory{Slots: make([]*InventorySlot, len(inv.Slots))}
+	for i, slot := range inv.Slots {
+		s := *slot
+		c.Slots[i] = &s
+	}
+	return c
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
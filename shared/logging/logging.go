@@ -0,0 +1,270 @@
+// Package logging is a levelled, structured logger shared by the server, client and patcher.
+//
+// Each logger belongs to a subsystem such as net, tick or chat, and every subsystem
+// has its own level so a noisy one can be turned up or off without touching the rest.
+// Lines are written as logfmt or JSON:
+//
+//	time=2017-09-01T12:00:00.000Z level=info sys=net msg="player connected" player=bob
+package logging
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Level is how important a log line is
+type Level int
+
+const (
+	DEBUG Level = iota
+	INFO
+	WARN
+	ERROR
+	OFF // disables a subsystem
+)
+
+func (l Level) String() string {
+	switch l {
+	case DEBUG:
+		return "debug"
+	case INFO:
+		return "info"
+	case WARN:
+		return "warn"
+	case ERROR:
+		return "error"
+	case OFF:
+		return "off"
+	default:
+		return "unknown"
+	}
+}
+
+// ParseLevel reads a level name as printed by Level.String
+func ParseLevel(name string) (Level, error) {
+	for l := DEBUG; l <= OFF; l++ {
+		if strings.EqualFold(name, l.String()) {
+			return l, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown log level %q", name)
+}
+
+// Format is how log lines are written
+type Format string
+
+const (
+	LOGFMT Format = "logfmt"
+	JSON   Format = "json"
+)
+
+var (
+	lock         sync.Mutex
+	out          io.Writer = os.Stderr
+	format                 = LOGFMT
+	defaultLevel           = INFO
+	levels                 = make(map[string]Level) // by subsystem, overriding defaultLevel
+	subsystems             = make(map[string]bool)
+)
+
+// SetOutput changes where every logger writes
+func SetOutput(w io.Writer) {
+	lock.Lock()
+	defer lock.Unlock()
+	out = w
+}
+
+// SetFormat changes how every logger writes
+func SetFormat(f Format) error {
+	if f != LOGFMT && f != JSON {
+		return fmt.Errorf("unknown log format %q, use %s or %s", f, LOGFMT, JSON)
+	}
+	lock.Lock()
+	defer lock.Unlock()
+	format = f
+	return nil
+}
+
+// Configure sets levels from a comma separated spec. A bare level sets the default
+// for every subsystem and subsystem=level overrides it, e.g. "info,net=debug,tick=off".
+// The spec replaces every level set before, and the default is info if it gives none
+func Configure(spec string) error {
+	newDefault, newLevels, err := parse(spec, INFO)
+	if err != nil {
+		return err
+	}
+	lock.Lock()
+	defer lock.Unlock()
+	defaultLevel = newDefault
+	levels = newLevels
+	return nil
+}
+
+// Adjust changes only the levels a spec in the form Configure accepts gives,
+// keeping the rest
+func Adjust(spec string) error {
+	lock.Lock()
+	newDefault := defaultLevel
+	lock.Unlock()
+	newDefault, newLevels, err := parse(spec, newDefault)
+	if err != nil {
+		return err
+	}
+	lock.Lock()
+	defer lock.Unlock()
+	defaultLevel = newDefault
+	for sys, level := range newLevels {
+		levels[sys] = level
+	}
+	return nil
+}
+
+// Validate checks a spec could be passed to Configure without applying it
+func Validate(spec string) error {
+	_, _, err := parse(spec, INFO)
+	return err
+}
+
+func parse(spec string, newDefault Level) (Level, map[string]Level, error) {
+	newLevels := make(map[string]Level)
+	for _, part := range strings.Split(spec, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		kv := strings.SplitN(part, "=", 2)
+		if len(kv) == 1 {
+			level, err := ParseLevel(kv[0])
+			if err != nil {
+				return 0, nil, err
+			}
+			newDefault = level
+			continue
+		}
+		level, err := ParseLevel(kv[1])
+		if err != nil {
+			return 0, nil, err
+		}
+		newLevels[strings.TrimSpace(kv[0])] = level
+	}
+	return newDefault, newLevels, nil
+}
+
+// Levels describes the level of every subsystem logged to so far, in the form Configure accepts
+func Levels() string {
+	lock.Lock()
+	defer lock.Unlock()
+	names := []string{}
+	for sys := range subsystems {
+		names = append(names, sys)
+	}
+	for sys := range levels {
+		if !subsystems[sys] {
+			names = append(names, sys)
+		}
+	}
+	sort.Strings(names)
+	parts := []string{defaultLevel.String()}
+	for _, sys := range names {
+		parts = append(parts, sys+"="+levelOf(sys).String())
+	}
+	return strings.Join(parts, ",")
+}
+
+// levelOf must be called with the lock held
+func levelOf(sys string) Level {
+	if level, ok := levels[sys]; ok {
+		return level
+	}
+	return defaultLevel
+}
+
+// Logger writes lines for one subsystem
+type Logger struct {
+	sys string
+}
+
+// New returns the logger of a subsystem
+func New(subsystem string) *Logger {
+	lock.Lock()
+	defer lock.Unlock()
+	subsystems[subsystem] = true
+	return &Logger{sys: subsystem}
+}
+
+// Enabled reports whether lines at a level would be written, to skip building expensive ones
+func (l *Logger) Enabled(level Level) bool {
+	lock.Lock()
+	defer lock.Unlock()
+	return level < OFF && level >= levelOf(l.sys)
+}
+
+// Debug logs detail only wanted while investigating. Fields are alternating keys and values
+func (l *Logger) Debug(msg string, fields ...interface{}) { l.log(DEBUG, msg, fields) }
+
+// Info logs normal operation
+func (l *Logger) Info(msg string, fields ...interface{}) { l.log(INFO, msg, fields) }
+
+// Warn logs something unexpected which was recovered from
+func (l *Logger) Warn(msg string, fields ...interface{}) { l.log(WARN, msg, fields) }
+
+// Error logs a failure
+func (l *Logger) Error(msg string, fields ...interface{}) { l.log(ERROR, msg, fields) }
+
+// Fatal logs a failure whatever the subsystem's level and exits
+func (l *Logger) Fatal(msg string, fields ...interface{}) {
+	l.write(ERROR, msg, fields)
+	os.Exit(1)
+}
+
+func (l *Logger) log(level Level, msg string, fields []interface{}) {
+	if !l.Enabled(level) {
+		return
+	}
+	l.write(level, msg, fields)
+}
+
+func (l *Logger) write(level Level, msg string, fields []interface{}) {
+	keys := []string{"time", "level", "sys", "msg"}
+	values := []string{time.Now().UTC().Format("2006-01-02T15:04:05.000Z07:00"), level.String(), l.sys, msg}
+	for i := 0; i < len(fields); i += 2 {
+		key := fmt.Sprint(fields[i])
+		value := "(missing)"
+		if i+1 < len(fields) {
+			value = fmt.Sprint(fields[i+1])
+		}
+		keys = append(keys, key)
+		values = append(values, value)
+	}
+
+	lock.Lock()
+	defer lock.Unlock()
+	if format == JSON {
+		line := make(map[string]string, len(keys))
+		for i, key := range keys {
+			line[key] = values[i]
+		}
+		data, _ := json.Marshal(line)
+		fmt.Fprintf(out, "%s\n", data)
+		return
+	}
+	parts := make([]string, len(keys))
+	for i, key := range keys {
+		parts[i] = key + "=" + logfmtValue(values[i])
+	}
+	fmt.Fprintln(out, strings.Join(parts, " "))
+}
+
+func logfmtValue(v string) string {
+	if v == "" || strings.ContainsAny(v, " =\"\t\n") {
+		return strconv.Quote(v)
+	}
+	return v
+}
@@ -2,6 +2,7 @@ package shared
 
 import (
 	"fmt"
+	"reflect"
 	"time"
 
 	"github.com/faiface/pixel"
@@ -15,24 +16,45 @@ type Message struct {
 }
 
 type Update struct {
-	PlayerMoved        *PlayerMoved        `,omitempty`
-	PlayerSpoke        *PlayerSpoke        `,omitempty`
-	WorldState         *WorldState         `,omitempty`
-	PlayerDisconnected *PlayerDisconnected `,omitempty`
+	EntitySpawned    *EntitySpawned    `,omitempty`
+	EntityMoved      *EntityMoved      `,omitempty`
+	EntityRemoved    *EntityRemoved    `,omitempty`
+	PlayerSpoke      *PlayerSpoke      `,omitempty`
+	WorldState       *WorldState       `,omitempty`
+	SpellBook        *SpellBook        `,omitempty`
+	CastStarted      *CastStarted      `,omitempty`
+	CastFailed       *CastFailed       `,omitempty`
+	SpellResolved    *SpellResolved    `,omitempty`
+	PlayerResources  *PlayerResources  `,omitempty`
+	InventoryUpdated *InventoryUpdated `,omitempty`
+	Notice           *Notice           `,omitempty`
+	EquipmentChanged *EquipmentChanged `,omitempty`
+	PlayerLevelled   *PlayerLevelled   `,omitempty`
+	Ping             *Ping             `,omitempty`
+	GameSettings     *GameSettings     `,omitempty`
 }
 
 type Request struct {
 	ConnectRequest *ConnectRequest `,omitempty`
 	MoveRequest    *MoveRequest    `,omitempty`
 	SpeakRequest   *SpeakRequest   `,omitempty`
+	ShootRequest   *ShootRequest   `,omitempty`
+	CastRequest    *CastRequest    `,omitempty`
+	PickupRequest  *PickupRequest  `,omitempty`
+	EquipRequest   *EquipRequest   `,omitempty`
+	UnequipRequest *UnequipRequest `,omitempty`
+	PongRequest    *PongRequest    `,omitempty`
 }
 
 type Error struct {
 	Message string
 }
 
+// ConnectRequest joins the game as the character with the ID. Token is from logging in
+// to the account the character belongs to, and may be empty on servers open to anyone
 type ConnectRequest struct {
-	ID string
+	ID    string
+	Token string `,omitempty`
 }
 
 type MoveRequest struct {
@@ -40,27 +62,163 @@ type MoveRequest struct {
 	Created   time.Time
 }
 
+// SpeakRequest is a line typed into chat. Lines starting with / are commands,
+// e.g. "/w name message", anything else is said to players nearby
 type SpeakRequest struct {
 	Text string
 }
 
-type PlayerMoved struct {
+type ShootRequest struct {
+	Direction pixel.Vec
+	Created   time.Time
+}
+
+type CastRequest struct {
+	Spell  string
+	Target pixel.Vec
+}
+
+type EntitySpawned struct {
+	Entity *Entity
+}
+
+type EntityMoved struct {
 	ID          string
 	NewPosition pixel.Vec
+	Facing      Direction
+	Action      Action
 	RequestTime time.Time
 }
 
+type EntityRemoved struct {
+	ID string
+	// HitID is the entity struck by a removed projectile, if any
+	HitID string
+}
+
+type PickupRequest struct {
+	EntityID string
+}
+
+type EquipRequest struct {
+	ItemID string
+}
+
+type UnequipRequest struct {
+	Slot EquipSlot
+}
+
 type PlayerSpoke struct {
-	ID   string
-	Text string
+	ID      string // speaker, empty for system messages
+	Text    string
+	Channel ChatChannel
+	To      string // recipient of a whisper
 }
 
+// WorldState is everything in the world, sent on joining. Messages are limited in size,
+// so a big world is sent in parts, in order, each with some of the entities
 type WorldState struct {
-	Players []*Player
+	Entities []*Entity
+	Part     int // counting from 0, which replaces whatever the client knew before
+	Parts    int
 }
 
-type PlayerDisconnected struct {
-	ID string
+// InventoryUpdated is only sent to the player who owns the inventory
+type InventoryUpdated struct {
+	Inventory *Inventory
+}
+
+type EquipmentChanged struct {
+	ID        string
+	Equipment *Equipment
+}
+
+// Notice is a message from the server for a single player
+type Notice struct {
+	Text string
+}
+
+type SpellBook struct {
+	Spells []*Spell
+}
+
+type CastStarted struct {
+	ID       string
+	Spell    string
+	Target   pixel.Vec
+	CastTime float64
+}
+
+type CastFailed struct {
+	Spell  string
+	Reason string
+}
+
+type SpellResolved struct {
+	ID       string
+	Spell    string
+	Target   pixel.Vec
+	Radius   float64
+	Affected []string
+}
+
+// PlayerResources is only sent to the player it describes
+type PlayerResources struct {
+	Health      float64
+	MaxHealth   float64
+	Mana        float64
+	MaxMana     float64
+	Cooldowns   map[string]float64 // seconds remaining by spell name
+	Level       int
+	XP          int
+	NextLevelXP int // total experience needed for the next level, zero at the max level
+	Strength    float64
+}
+
+// Ping asks the client to answer with a PongRequest so the server can measure round trip time
+type Ping struct {
+	Sent time.Time // server time, echoed back unchanged
+}
+
+// PongRequest answers a Ping
+type PongRequest struct {
+	Sent time.Time
+}
+
+// GameSettings are server settings the client needs to predict the game,
+// sent on connecting and whenever the server reloads its config
+type GameSettings struct {
+	MoveSpeed     float64 // units per MoveRequest
+	ShootCooldown float64 // seconds between shots
+}
+
+// PlayerLevelled is sent to everyone when a player reaches a new level
+type PlayerLevelled struct {
+	ID    string
+	Level int
+}
+
+// Type names the request or update a message carries, e.g. "MoveRequest"
+func (m Message) Type() string {
+	switch {
+	case m.Error != nil:
+		return "Error"
+	case m.Request != nil:
+		return setField(reflect.ValueOf(*m.Request))
+	case m.Update != nil:
+		return setField(reflect.ValueOf(*m.Update))
+	}
+	return "Ping"
+}
+
+// setField returns the name of the first non nil field of a Request or Update
+func setField(v reflect.Value) string {
+	for i := 0; i < v.NumField(); i++ {
+		if !v.Field(i).IsNil() {
+			return v.Type().Field(i).Name
+		}
+	}
+	return "Empty"
 }
 
 func (m Message) String() string {
@@ -83,20 +241,58 @@ func (m Message) String() string {
 }
 
 func (u Update) String() string {
-	if u.PlayerMoved != nil {
-		return fmt.Sprintf("PlayerMoved: %s: %s", u.PlayerMoved.ID, u.PlayerMoved.NewPosition)
+	if u.EntitySpawned != nil {
+		return fmt.Sprintf("EntitySpawned: %s %s: %s", u.EntitySpawned.Entity.Kind, u.EntitySpawned.Entity.ID, u.EntitySpawned.Entity.Position)
+	}
+
+	if u.EntityMoved != nil {
+		return fmt.Sprintf("EntityMoved: %s: %s", u.EntityMoved.ID, u.EntityMoved.NewPosition)
+	}
+
+	if u.EntityRemoved != nil {
+		return fmt.Sprintf("EntityRemoved: %s", u.EntityRemoved.ID)
 	}
 
 	if u.PlayerSpoke != nil {
-		return fmt.Sprintf("PlayerSpoke: %s: %s", u.PlayerSpoke.ID, u.PlayerSpoke.Text)
+		return fmt.Sprintf("PlayerSpoke: [%s] %s: %s", u.PlayerSpoke.Channel, u.PlayerSpoke.ID, u.PlayerSpoke.Text)
 	}
 
 	if u.WorldState != nil {
 
-		return fmt.Sprintf("WorldState: %v players", len(u.WorldState.Players))
+		return fmt.Sprintf("WorldState: part %v of %v, %v entities", u.WorldState.Part+1, u.WorldState.Parts, len(u.WorldState.Entities))
+	}
+	if u.SpellBook != nil {
+		return fmt.Sprintf("SpellBook: %v spells", len(u.SpellBook.Spells))
+	}
+	if u.CastStarted != nil {
+		return fmt.Sprintf("CastStarted: %s: %s at %s", u.CastStarted.ID, u.CastStarted.Spell, u.CastStarted.Target)
+	}
+	if u.CastFailed != nil {
+		return fmt.Sprintf("CastFailed: %s: %s", u.CastFailed.Spell, u.CastFailed.Reason)
+	}
+	if u.SpellResolved != nil {
+		return fmt.Sprintf("SpellResolved: %s: %s hit %v", u.SpellResolved.ID, u.SpellResolved.Spell, u.SpellResolved.Affected)
+	}
+	if u.PlayerResources != nil {
+		return fmt.Sprintf("PlayerResources: health %v mana %v", u.PlayerResources.Health, u.PlayerResources.Mana)
+	}
+	if u.InventoryUpdated != nil {
+		return fmt.Sprintf("InventoryUpdated: %v slots", len(u.InventoryUpdated.Inventory.Slots))
+	}
+	if u.Notice != nil {
+		return fmt.Sprintf("Notice: %s", u.Notice.Text)
+	}
+	if u.EquipmentChanged != nil {
+		return fmt.Sprintf("EquipmentChanged: %s: %+v", u.EquipmentChanged.ID, *u.EquipmentChanged.Equipment)
 	}
-	if u.PlayerDisconnected != nil {
-		return fmt.Sprintf("PlayerDisconnected: %s", u.PlayerDisconnected)
+	if u.PlayerLevelled != nil {
+		return fmt.Sprintf("PlayerLevelled: %s reached level %v", u.PlayerLevelled.ID, u.PlayerLevelled.Level)
+	}
+	if u.Ping != nil {
+		return fmt.Sprintf("Ping: %v", u.Ping.Sent)
+	}
+	if u.GameSettings != nil {
+		return fmt.Sprintf("GameSettings: %+v", *u.GameSettings)
 	}
 
 	return "empty update"
@@ -113,6 +309,24 @@ func (r Request) String() string {
 	if r.SpeakRequest != nil {
 		return fmt.Sprintf("SpeakRequest: %s", r.SpeakRequest.Text)
 	}
+	if r.ShootRequest != nil {
+		return fmt.Sprintf("ShootRequest: %s", r.ShootRequest.Direction)
+	}
+	if r.CastRequest != nil {
+		return fmt.Sprintf("CastRequest: %s at %s", r.CastRequest.Spell, r.CastRequest.Target)
+	}
+	if r.PickupRequest != nil {
+		return fmt.Sprintf("PickupRequest: %s", r.PickupRequest.EntityID)
+	}
+	if r.EquipRequest != nil {
+		return fmt.Sprintf("EquipRequest: %s", r.EquipRequest.ItemID)
+	}
+	if r.UnequipRequest != nil {
+		return fmt.Sprintf("UnequipRequest: %s", r.UnequipRequest.Slot)
+	}
+	if r.PongRequest != nil {
+		return fmt.Sprintf("PongRequest: %v", r.PongRequest.Sent)
+	}
 
 	return "empty request"
 }
@@ -0,0 +1,161 @@
+// Package pathfind finds paths over the tile grid of a shared.TileMap with A*.
+//
+// Paths move between the eight neighbours of a tile, but never cut the corner of a
+// blocked tile. The search is deterministic, so the server can use it for NPCs and
+// still replay a recorded session exactly
+package pathfind
+
+import (
+	"container/heap"
+	"math"
+
+	"github.com/faiface/pixel"
+	"github.com/mmogo/mmo/shared"
+)
+
+// DefaultMaxNodes bounds searches so that an unreachable goal can't stall the caller
+const DefaultMaxNodes = 4096
+
+// neighbours are the steps to the tiles around a tile, straight ones first
+var neighbours = []shared.Tile{
+	{1, 0}, {-1, 0}, {0, 1}, {0, -1},
+	{1, 1}, {1, -1}, {-1, 1}, {-1, -1},
+}
+
+// Find returns the tiles to walk through to get from one tile to another, leaving out
+// the start and ending with the goal. It reports false if the goal can't be reached
+// without looking at more than maxNodes tiles
+func Find(m *shared.TileMap, from, to shared.Tile, maxNodes int) ([]shared.Tile, bool) {
+	if m.Blocked(to) {
+		return nil, false
+	}
+	if from == to {
+		return []shared.Tile{}, true
+	}
+	start := &node{tile: from, estimate: distance(from, to)}
+	nodes := map[shared.Tile]*node{from: start}
+	open := &queue{}
+	heap.Push(open, start)
+	expanded := 0
+	for open.Len() > 0 {
+		current := heap.Pop(open).(*node)
+		if current.tile == to {
+			return current.path(), true
+		}
+		current.closed = true
+		expanded++
+		if expanded > maxNodes {
+			return nil, false
+		}
+		for _, step := range neighbours {
+			next := shared.Tile{X: current.tile.X + step.X, Y: current.tile.Y + step.Y}
+			if m.Blocked(next) {
+				continue
+			}
+			// diagonal steps need both tiles beside them open
+			if step.X != 0 && step.Y != 0 &&
+				(m.Blocked(shared.Tile{X: current.tile.X + step.X, Y: current.tile.Y}) ||
+					m.Blocked(shared.Tile{X: current.tile.X, Y: current.tile.Y + step.Y})) {
+				continue
+			}
+			cost := current.cost + distance(current.tile, next)
+			n, seen := nodes[next]
+			if seen && (n.closed || cost >= n.cost) {
+				continue
+			}
+			if !seen {
+				n = &node{tile: next, order: len(nodes)}
+				nodes[next] = n
+			}
+			n.parent = current
+			n.cost = cost
+			n.estimate = cost + distance(next, to)
+			if seen && n.index >= 0 {
+				heap.Fix(open, n.index)
+			} else {
+				heap.Push(open, n)
+			}
+		}
+	}
+	return nil, false
+}
+
+// Path returns the points to walk through to get from one position to another: the centre of
+// each tile on the way, then the destination itself. It reports false if there is no path
+func Path(m *shared.TileMap, from, to pixel.Vec, maxNodes int) ([]pixel.Vec, bool) {
+	tiles, ok := Find(m, shared.TileAt(from), shared.TileAt(to), maxNodes)
+	if !ok {
+		return nil, false
+	}
+	waypoints := make([]pixel.Vec, 0, len(tiles)+1)
+	if len(tiles) > 0 {
+		// the goal tile's centre is left out, walking on to the destination instead
+		for _, t := range tiles[:len(tiles)-1] {
+			waypoints = append(waypoints, shared.TileCenter(t))
+		}
+	}
+	return append(waypoints, to), true
+}
+
+// distance is the octile distance between two tiles: the cost of the cheapest
+// walk between them with nothing in the way
+func distance(a, b shared.Tile) float64 {
+	dx := math.Abs(float64(a.X - b.X))
+	dy := math.Abs(float64(a.Y - b.Y))
+	return math.Max(dx, dy) + (math.Sqrt2-1)*math.Min(dx, dy)
+}
+
+// node is a tile reached by the search
+type node struct {
+	tile     shared.Tile
+	parent   *node
+	cost     float64 // of the best walk found from the start
+	estimate float64 // of the whole walk to the goal through this tile
+	closed   bool
+	order    int // when the tile was first reached, to break ties the same way every time
+	index    int // in the queue, -1 once popped
+}
+
+// path returns the tiles from the start to this node, leaving out the start
+func (n *node) path() []shared.Tile {
+	tiles := []shared.Tile{}
+	for ; n.parent != nil; n = n.parent {
+		tiles = append(tiles, n.tile)
+	}
+	for i, j := 0, len(tiles)-1; i < j; i, j = i+1, j-1 {
+		tiles[i], tiles[j] = tiles[j], tiles[i]
+	}
+	return tiles
+}
+
+// queue is the open set, a heap of nodes by lowest estimate
+type queue []*node
+
+func (q queue) Len() int { return len(q) }
+
+func (q queue) Less(i, j int) bool {
+	if q[i].estimate != q[j].estimate {
+		return q[i].estimate < q[j].estimate
+	}
+	return q[i].order < q[j].order
+}
+
+func (q queue) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+	q[i].index = i
+	q[j].index = j
+}
+
+func (q *queue) Push(x interface{}) {
+	n := x.(*node)
+	n.index = len(*q)
+	*q = append(*q, n)
+}
+
+func (q *queue) Pop() interface{} {
+	old := *q
+	n := old[len(old)-1]
+	n.index = -1
+	*q = old[:len(old)-1]
+	return n
+}
@@ -0,0 +1,144 @@
+package pathfind
+
+import (
+	"testing"
+
+	"github.com/faiface/pixel"
+	"github.com/mmogo/mmo/shared"
+)
+
+func TestFind(t *testing.T) {
+	tests := []struct {
+		name     string
+		walls    []shared.Wall
+		from, to shared.Tile
+		maxNodes int
+		ok       bool
+		length   int // tiles in the path, if found
+	}{
+		{
+			name:     "straight line",
+			from:     shared.Tile{0, 0},
+			to:       shared.Tile{5, 0},
+			maxNodes: DefaultMaxNodes,
+			ok:       true,
+			length:   5,
+		},
+		{
+			name:     "already there",
+			from:     shared.Tile{3, 3},
+			to:       shared.Tile{3, 3},
+			maxNodes: DefaultMaxNodes,
+			ok:       true,
+			length:   0,
+		},
+		{
+			name:     "detour around a wall",
+			walls:    []shared.Wall{{From: shared.Tile{2, -3}, To: shared.Tile{2, 3}}},
+			from:     shared.Tile{0, 0},
+			to:       shared.Tile{4, 0},
+			maxNodes: DefaultMaxNodes,
+			ok:       true,
+			// up past the end of the wall and back down, without cutting its corners
+			length: 10,
+		},
+		{
+			name:     "blocked goal",
+			walls:    []shared.Wall{{From: shared.Tile{4, 0}, To: shared.Tile{4, 0}}},
+			from:     shared.Tile{0, 0},
+			to:       shared.Tile{4, 0},
+			maxNodes: DefaultMaxNodes,
+		},
+		{
+			name: "walled in goal",
+			walls: []shared.Wall{
+				{From: shared.Tile{3, -1}, To: shared.Tile{5, -1}},
+				{From: shared.Tile{3, 1}, To: shared.Tile{5, 1}},
+				{From: shared.Tile{3, 0}, To: shared.Tile{3, 0}},
+				{From: shared.Tile{5, 0}, To: shared.Tile{5, 0}},
+			},
+			from:     shared.Tile{0, 0},
+			to:       shared.Tile{4, 0},
+			maxNodes: DefaultMaxNodes,
+		},
+		{
+			name:     "goal off the map",
+			from:     shared.Tile{0, 0},
+			to:       shared.Tile{shared.MapSize, 0},
+			maxNodes: DefaultMaxNodes,
+		},
+		{
+			name:     "too far to search",
+			from:     shared.Tile{-20, -20},
+			to:       shared.Tile{20, 20},
+			maxNodes: 10,
+		},
+		{
+			name:     "far enough to search",
+			from:     shared.Tile{-20, -20},
+			to:       shared.Tile{20, 20},
+			maxNodes: DefaultMaxNodes,
+			ok:       true,
+			length:   40,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			m := shared.NewTileMap(shared.MapSize)
+			m.Block(test.walls)
+			path, ok := Find(m, test.from, test.to, test.maxNodes)
+			if ok != test.ok {
+				t.Fatalf("found a path %v, want %v", ok, test.ok)
+			}
+			if !ok {
+				return
+			}
+			if len(path) != test.length {
+				t.Errorf("path %v is %v tiles, want %v", path, len(path), test.length)
+			}
+			checkWalkable(t, m, test.from, path)
+			if len(path) > 0 && path[len(path)-1] != test.to {
+				t.Errorf("path ends at %v, want %v", path[len(path)-1], test.to)
+			}
+		})
+	}
+}
+
+// checkWalkable checks each step of a path is to an open neighbouring tile, without cutting corners
+func checkWalkable(t *testing.T, m *shared.TileMap, from shared.Tile, path []shared.Tile) {
+	at := from
+	for _, next := range path {
+		dx, dy := next.X-at.X, next.Y-at.Y
+		if dx < -1 || dx > 1 || dy < -1 || dy > 1 || (dx == 0 && dy == 0) {
+			t.Fatalf("step from %v to %v is not to a neighbour", at, next)
+		}
+		if m.Blocked(next) {
+			t.Fatalf("step from %v to %v is into a blocked tile", at, next)
+		}
+		if dx != 0 && dy != 0 && (m.Blocked(shared.Tile{at.X + dx, at.Y}) || m.Blocked(shared.Tile{at.X, at.Y + dy})) {
+			t.Fatalf("step from %v to %v cuts the corner of a blocked tile", at, next)
+		}
+		at = next
+	}
+}
+
+func TestPath(t *testing.T) {
+	m := shared.NewTileMap(shared.MapSize)
+	m.Block([]shared.Wall{{From: shared.Tile{1, -1}, To: shared.Tile{1, 1}}})
+	from, to := pixel.V(5, 5), pixel.V(2*shared.TileSize+10, 0)
+	path, ok := Path(m, from, to, DefaultMaxNodes)
+	if !ok {
+		t.Fatal("no path found")
+	}
+	if last := path[len(path)-1]; last != to {
+		t.Errorf("path ends at %v, want the destination %v", last, to)
+	}
+	for _, point := range path {
+		if m.BlockedAt(point) {
+			t.Errorf("path %v goes through blocked %v", path, point)
+		}
+	}
+	if _, ok := Path(m, from, shared.TileCenter(shared.Tile{1, 0}), DefaultMaxNodes); ok {
+		t.Error("found a path into a wall")
+	}
+}
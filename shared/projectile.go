@@ -0,0 +1,22 @@
+package shared
+
+import "github.com/faiface/pixel"
+
+// Projectile is the component of a missile simulated by the server, such as an arrow
+type Projectile struct {
+	OwnerID  string
+	Velocity pixel.Vec // units per second
+	Range    float64
+	Traveled float64
+}
+
+// Step advances a projectile entity by dt seconds and reports whether it is still within range
+func (e *Entity) Step(dt float64) bool {
+	if e.Projectile == nil {
+		return false
+	}
+	delta := e.Projectile.Velocity.Scaled(dt)
+	e.Position = e.Position.Add(delta)
+	e.Projectile.Traveled += delta.Len()
+	return e.Projectile.Traveled < e.Projectile.Range
+}
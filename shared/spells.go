@@ -0,0 +1,79 @@
+package shared
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+)
+
+// SpellEffect is what a spell does to the players caught in its area
+type SpellEffect string
+
+const (
+	EffectDamage SpellEffect = "damage"
+	EffectHeal   SpellEffect = "heal"
+)
+
+// Spell is the definition of a castable spell.
+// Spells are loaded from JSON, e.g.
+//
+//	[{"Name": "fireball", "CastTime": 1.5, "Cooldown": 4, "ManaCost": 20, "Range": 400, "Radius": 96, "Effect": "damage", "Amount": 25}]
+type Spell struct {
+	Name     string
+	CastTime float64 // seconds
+	Cooldown float64 // seconds
+	ManaCost float64
+	Range    float64 // furthest the target can be from the caster
+	Radius   float64
+	Effect   SpellEffect
+	Amount   float64
+}
+
+// DefaultSpells are used when no spell file is given
+var DefaultSpells = []*Spell{
+	{Name: "fireball", CastTime: 1.5, Cooldown: 4, ManaCost: 20, Range: 400, Radius: 96, Effect: EffectDamage, Amount: 25},
+	{Name: "frost nova", CastTime: 0.5, Cooldown: 10, ManaCost: 35, Range: 300, Radius: 160, Effect: EffectDamage, Amount: 15},
+	{Name: "heal", CastTime: 2, Cooldown: 6, ManaCost: 25, Range: 300, Radius: 64, Effect: EffectHeal, Amount: 30},
+}
+
+// LoadSpells reads and validates spell definitions from a JSON file
+func LoadSpells(path string) ([]*Spell, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var spells []*Spell
+	if err := json.Unmarshal(data, &spells); err != nil {
+		return nil, fmt.Errorf("parsing %s: %v", path, err)
+	}
+	names := make(map[string]bool)
+	for _, spell := range spells {
+		if err := spell.Validate(); err != nil {
+			return nil, err
+		}
+		if names[spell.Name] {
+			return nil, fmt.Errorf("duplicate spell %q", spell.Name)
+		}
+		names[spell.Name] = true
+	}
+	return spells, nil
+}
+
+// Validate checks the spell definition is usable
+func (s *Spell) Validate() error {
+	if s.Name == "" {
+		return fmt.Errorf("spell has no name")
+	}
+	if s.CastTime < 0 || s.Cooldown < 0 || s.ManaCost < 0 || s.Radius < 0 || s.Amount < 0 {
+		return fmt.Errorf("spell %q has negative values", s.Name)
+	}
+	if s.Range <= 0 {
+		return fmt.Errorf("spell %q needs a positive Range", s.Name)
+	}
+	switch s.Effect {
+	case EffectDamage, EffectHeal:
+	default:
+		return fmt.Errorf("spell %q has unknown effect %q", s.Name, s.Effect)
+	}
+	return nil
+}
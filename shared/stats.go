@@ -0,0 +1,127 @@
+package shared
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math"
+)
+
+// Stats are a player's character statistics
+type Stats struct {
+	Level     int
+	XP        int // total experience earned
+	Strength  float64
+	Health    float64
+	MaxHealth float64
+	Mana      float64
+	MaxMana   float64
+}
+
+// Progression defines how experience turns into levels and what each level is worth.
+// Progressions are loaded from JSON, e.g.
+//
+//	{"MaxLevel": 20, "BaseXP": 100, "Growth": 1.5, "BaseHealth": 100, "HealthPerLevel": 10,
+//	 "BaseMana": 100, "ManaPerLevel": 5, "BaseStrength": 0, "StrengthPerLevel": 1}
+type Progression struct {
+	MaxLevel         int
+	BaseXP           float64 // experience needed to reach level 2
+	Growth           float64 // each level needs this many times the experience of the last
+	BaseHealth       float64
+	HealthPerLevel   float64
+	BaseMana         float64
+	ManaPerLevel     float64
+	BaseStrength     float64
+	StrengthPerLevel float64
+}
+
+// DefaultProgression is used when no progression file is given
+var DefaultProgression = &Progression{
+	MaxLevel:         20,
+	BaseXP:           100,
+	Growth:           1.5,
+	BaseHealth:       100,
+	HealthPerLevel:   10,
+	BaseMana:         100,
+	ManaPerLevel:     5,
+	BaseStrength:     0,
+	StrengthPerLevel: 1,
+}
+
+// LoadProgression reads and validates a progression from a JSON file
+func LoadProgression(path string) (*Progression, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var p Progression
+	if err := json.Unmarshal(data, &p); err != nil {
+		return nil, fmt.Errorf("parsing %s: %v", path, err)
+	}
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// Validate checks the progression is usable
+func (p *Progression) Validate() error {
+	if p.MaxLevel < 1 {
+		return fmt.Errorf("progression needs a max level of at least 1")
+	}
+	if p.BaseXP <= 0 || p.Growth < 1 {
+		return fmt.Errorf("progression needs positive base xp and growth of at least 1")
+	}
+	if p.BaseHealth <= 0 || p.HealthPerLevel < 0 || p.BaseMana < 0 || p.ManaPerLevel < 0 ||
+		p.BaseStrength < 0 || p.StrengthPerLevel < 0 {
+		return fmt.Errorf("progression has negative values")
+	}
+	if p.xpForLevel(p.MaxLevel) > math.MaxInt32 {
+		return fmt.Errorf("progression needs more than %v xp to reach level %v, lower the max level, base xp or growth", math.MaxInt32, p.MaxLevel)
+	}
+	return nil
+}
+
+// XPForLevel returns the total experience needed to reach a level,
+// at most math.MaxInt32 so it fits an int on every platform
+func (p *Progression) XPForLevel(level int) int {
+	return int(math.Min(p.xpForLevel(level), math.MaxInt32))
+}
+
+func (p *Progression) xpForLevel(level int) float64 {
+	xp := 0.0
+	for l := 1; l < level; l++ {
+		xp += p.BaseXP * math.Pow(p.Growth, float64(l-1))
+	}
+	return xp
+}
+
+// LevelForXP returns the level reached with the given total experience
+func (p *Progression) LevelForXP(xp int) int {
+	level := 1
+	for level < p.MaxLevel && xp >= p.XPForLevel(level+1) {
+		level++
+	}
+	return level
+}
+
+// NewStats returns the stats of a fresh level 1 character
+func (p *Progression) NewStats() *Stats {
+	stats := &Stats{Level: 1}
+	p.Apply(stats)
+	stats.Health = stats.MaxHealth
+	stats.Mana = stats.MaxMana
+	return stats
+}
+
+// Apply sets the level and derived stats from the experience earned,
+// keeping current health and mana within their new maximums
+func (p *Progression) Apply(stats *Stats) {
+	stats.Level = p.LevelForXP(stats.XP)
+	gained := float64(stats.Level - 1)
+	stats.MaxHealth = p.BaseHealth + p.HealthPerLevel*gained
+	stats.MaxMana = p.BaseMana + p.ManaPerLevel*gained
+	stats.Strength = p.BaseStrength + p.StrengthPerLevel*gained
+	stats.Health = math.Min(stats.Health, stats.MaxHealth)
+	stats.Mana = math.Min(stats.Mana, stats.MaxMana)
+}
@@ -0,0 +1,21 @@
+package shared
+
+import (
+	"math"
+	"testing"
+)
+
+func TestProgressionXPFitsAnInt(t *testing.T) {
+	if err := DefaultProgression.Validate(); err != nil {
+		t.Errorf("default progression is invalid: %v", err)
+	}
+	steep := *DefaultProgression
+	steep.MaxLevel = 100
+	steep.Growth = 2
+	if err := steep.Validate(); err == nil {
+		t.Error("progression needing more xp than an int holds is valid")
+	}
+	if xp := steep.XPForLevel(100); xp != math.MaxInt32 {
+		t.Errorf("xp for level 100 is %v, want it capped at %v", xp, math.MaxInt32)
+	}
+}
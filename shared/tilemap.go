@@ -0,0 +1,115 @@
+package shared
+
+import (
+	"math"
+
+	"github.com/faiface/pixel"
+)
+
+// TileSize is the width and height of a map tile before isometric projection
+const TileSize = 64.0
+
+// MapSize is how many tiles wide and high the world is
+const MapSize = 100
+
+// Tile is the position of a single tile on the map grid
+type Tile struct {
+	X, Y int
+}
+
+// TileMap describes which tiles of the world can be passed through
+type TileMap struct {
+	Min, Max Tile
+	blocked  map[Tile]bool
+}
+
+// NewTileMap returns an open map of size*size tiles centered on the origin
+func NewTileMap(size int) *TileMap {
+	return &TileMap{
+		Min:     Tile{-size / 2, -size / 2},
+		Max:     Tile{size / 2, size / 2},
+		blocked: make(map[Tile]bool),
+	}
+}
+
+// Wall is a rectangle of blocked tiles, from one corner tile to the opposite one
+type Wall struct {
+	From, To Tile
+}
+
+// DefaultWalls are the blocked parts of the world. The client and server both build their
+// tile maps from them, since the client predicts moves and plans paths as the server does
+var DefaultWalls = []Wall{
+	// a ruined wall north of the spawn, with a gap in the middle
+	{From: Tile{-8, 8}, To: Tile{-1, 8}},
+	{From: Tile{1, 8}, To: Tile{8, 8}},
+	// a walled yard to the east, open to the west
+	{From: Tile{12, -6}, To: Tile{20, -6}},
+	{From: Tile{12, 6}, To: Tile{20, 6}},
+	{From: Tile{20, -5}, To: Tile{20, 5}},
+	// rocks and a pond
+	{From: Tile{-4, -12}, To: Tile{-2, -10}},
+	{From: Tile{-22, 4}, To: Tile{-17, 9}},
+	{From: Tile{6, -20}, To: Tile{9, -18}},
+}
+
+// DefaultTileMap returns the map of the world, blocked by DefaultWalls
+func DefaultTileMap() *TileMap {
+	m := NewTileMap(MapSize)
+	m.Block(DefaultWalls)
+	return m
+}
+
+// Block marks the tiles of walls as impassable
+func (m *TileMap) Block(walls []Wall) {
+	for _, wall := range walls {
+		minX, maxX := wall.From.X, wall.To.X
+		if minX > maxX {
+			minX, maxX = maxX, minX
+		}
+		minY, maxY := wall.From.Y, wall.To.Y
+		if minY > maxY {
+			minY, maxY = maxY, minY
+		}
+		for x := minX; x <= maxX; x++ {
+			for y := minY; y <= maxY; y++ {
+				m.SetBlocked(Tile{x, y}, true)
+			}
+		}
+	}
+}
+
+// SetBlocked marks a tile as impassable
+func (m *TileMap) SetBlocked(t Tile, blocked bool) {
+	if blocked {
+		m.blocked[t] = true
+		return
+	}
+	delete(m.blocked, t)
+}
+
+// Blocked reports whether a tile is impassable. Tiles off the map are always blocked
+func (m *TileMap) Blocked(t Tile) bool {
+	if t.X < m.Min.X || t.X > m.Max.X || t.Y < m.Min.Y || t.Y > m.Max.Y {
+		return true
+	}
+	return m.blocked[t]
+}
+
+// BlockedAt reports whether the tile at a world position is impassable
+func (m *TileMap) BlockedAt(pos pixel.Vec) bool {
+	return m.Blocked(TileAt(pos))
+}
+
+// TileAt returns the tile at a world position. Tiles are centred on multiples of TileSize
+func TileAt(pos pixel.Vec) Tile {
+	return Tile{
+		X: int(math.Floor(pos.X/TileSize + 0.5)),
+		Y: int(math.Floor(pos.Y/TileSize + 0.5)),
+	}
+}
+
+// TileCenter returns the world position of the middle of a tile
+func TileCenter(t Tile) pixel.Vec {
+	return pixel.V(float64(t.X)*TileSize, float64(t.Y)*TileSize)
+}
@@ -0,0 +1,36 @@
+package shared
+
+import (
+	"testing"
+
+	"github.com/faiface/pixel"
+)
+
+func TestBlock(t *testing.T) {
+	m := NewTileMap(10)
+	// corners may be given either way round
+	m.Block([]Wall{{From: Tile{2, 3}, To: Tile{1, 1}}})
+	for x := -1; x <= 4; x++ {
+		for y := -1; y <= 4; y++ {
+			want := x >= 1 && x <= 2 && y >= 1 && y <= 3
+			if got := m.Blocked(Tile{x, y}); got != want {
+				t.Errorf("tile %v,%v blocked %v, want %v", x, y, got, want)
+			}
+		}
+	}
+	if !m.Blocked(Tile{6, 0}) {
+		t.Error("tile off the map is open")
+	}
+}
+
+func TestDefaultTileMap(t *testing.T) {
+	m := DefaultTileMap()
+	if m.BlockedAt(pixel.ZV) {
+		t.Error("players spawn in a wall")
+	}
+	for _, wall := range DefaultWalls {
+		if !m.Blocked(wall.From) || !m.Blocked(wall.To) {
+			t.Errorf("wall %+v is not blocked", wall)
+		}
+	}
+}
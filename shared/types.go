@@ -6,6 +6,7 @@ import (
 	"net"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/faiface/pixel"
 )
@@ -13,20 +14,30 @@ import (
 const fatalErrSig = "**FATAL_ERR**"
 
 type ServerPlayer struct {
-	*Player
+	*Entity
 	Conn         net.Conn
 	RequestQueue []*Message
 	QueueLock    sync.RWMutex
+	*Stats
+	Cooldowns map[string]time.Time // when each spell is ready again
+	LastShot  time.Time
+	DeadUntil time.Time // when a dead player respawns, zero while alive
+	Casting   *Cast
+	Inventory *Inventory
+	RTT       int64 // last measured round trip time in nanoseconds, accessed atomically
 }
 
-type ClientPlayer struct {
-	*Player
-	Color color.Color
+// Cast is a spell being channelled by a player
+type Cast struct {
+	Spell     *Spell
+	Target    pixel.Vec
+	Completes time.Time
 }
 
-type Player struct {
-	ID       string
-	Position pixel.Vec
+type ClientPlayer struct {
+	*Entity
+	Color     color.Color
+	LastMoved time.Time
 }
 
 type fatalError struct {
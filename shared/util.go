@@ -1,15 +1,40 @@
 package shared
 
-import "github.com/faiface/pixel"
+import (
+	"math"
 
-// MapToIso converts cartesian coordinates to isometric
-func MapToIso(cart pixel.Vec) pixel.Vec {
-	return pixel.Vec{cart.X - cart.Y, (cart.X + cart.Y) / 2}
+	"github.com/faiface/pixel"
+)
+
+// The world is a flat cartesian map measured in world units, TileSize to a tile, and every
+// position in the game is in world coordinates. Only drawing and mouse input use screen
+// coordinates, where the map is turned 45 degrees and squashed to half height
+
+// IsoMatrix is the world to screen transform, for drawing batches laid out in world coordinates
+var IsoMatrix = pixel.IM.Rotated(pixel.ZV, math.Pi/4).ScaledXY(pixel.ZV, pixel.V(1, 0.5))
+
+// MapToIso converts world coordinates to screen coordinates
+func MapToIso(world pixel.Vec) pixel.Vec {
+	return pixel.V((world.X-world.Y)/math.Sqrt2, (world.X+world.Y)/(2*math.Sqrt2))
+}
+
+// IsoToMap converts screen coordinates to world coordinates, undoing MapToIso
+func IsoToMap(screen pixel.Vec) pixel.Vec {
+	diff := screen.X * math.Sqrt2    // x - y
+	sum := screen.Y * 2 * math.Sqrt2 // x + y
+	return pixel.V((sum+diff)/2, (sum-diff)/2)
+}
+
+// ScreenDirection returns the way a movement through the world looks on screen, for facing sprites
+func ScreenDirection(world pixel.Vec) Direction {
+	if world == pixel.ZV {
+		return DIR_NONE
+	}
+	return UnitToDirection(MapToIso(world).Unit())
 }
 
-// IsoToMap converts isometric coordinates to cartesion
-func IsoToMap(iso pixel.Vec) pixel.Vec {
-	x := (iso.X + (2 * iso.Y))
-	y := ((2 * iso.Y) + iso.X) / 2
-	return pixel.Vec{x, y}
+// Depth orders things drawn at world positions. The deeper they are the further up the
+// screen they appear, and the earlier they must be drawn to be overlapped by what is in front
+func Depth(world pixel.Vec) float64 {
+	return MapToIso(world).Y
 }